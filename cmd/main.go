@@ -1,15 +1,29 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/blob"
 	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/config"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/geocoding"
 	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/handlers"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/i18n"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/jobs"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository/memory"
 	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository/mongodb"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/search"
 	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/services"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/sms"
 	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/middleware"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
 )
 
 func main() {
@@ -19,17 +33,123 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
-	// MongoDB 리포지토리 초기화
-	userRepo, err := mongodb.NewUserRepository(cfg.MongoURI)
+	// 서브커맨드: migrate up|down|status
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
+	// 리포지토리 초기화
+	repos, err := newRepositories(cfg)
+	if err != nil {
+		log.Fatalf("Failed to create repositories: %v", err)
+	}
+
+	rules, err := validationRules(cfg)
 	if err != nil {
-		log.Fatalf("Failed to create user repository: %v", err)
+		log.Fatalf("Invalid validation rules: %v", err)
 	}
 
 	// 서비스 초기화
-	userService := services.NewUserService(userRepo)
+	userService := services.NewUserService(repos.users).
+		WithDeletionGracePeriod(cfg.DeletionGracePeriod).
+		WithExports(repos.exports, cfg.ExportRetention).
+		WithValidationRules(rules)
+
+	// 지오코더 (좌표 없이 저장되는 주소의 위치 계산)
+	switch cfg.Geocoder {
+	case "static":
+		geocoder, err := geocoding.NewStaticGeocoder(cfg.GeocoderTable)
+		if err != nil {
+			log.Fatalf("Failed to load geocoder table: %v", err)
+		}
+		userService.WithGeocoder(geocoder)
+	case "", "none":
+		log.Printf("Geocoding disabled (only explicit coordinates are used)")
+	default:
+		log.Fatalf("Unknown geocoder: %s", cfg.Geocoder)
+	}
+
+	// 전화번호 인증 문자 발송기
+	switch cfg.SMSSender {
+	case "log":
+		userService.WithPhoneVerification(repos.verifications, sms.NewLogSender(), verificationPolicy(cfg))
+	case "file":
+		sender, err := sms.NewFileSender(cfg.SMSOutbox)
+		if err != nil {
+			log.Fatalf("Failed to open SMS outbox: %v", err)
+		}
+		userService.WithPhoneVerification(repos.verifications, sender, verificationPolicy(cfg))
+	case "", "none":
+		log.Printf("Phone verification disabled (no SMS sender)")
+	default:
+		log.Fatalf("Unknown SMS sender: %s", cfg.SMSSender)
+	}
+
+	// 프로필 이미지 저장소
+	var blobHandler http.Handler
+	switch cfg.BlobStore {
+	case "local":
+		store, err := blob.NewLocalStore(cfg.BlobDir, cfg.BlobBaseURL)
+		if err != nil {
+			log.Fatalf("Failed to open blob directory: %v", err)
+		}
+		userService.WithAvatars(store, avatarPolicy(cfg))
+		if strings.HasPrefix(cfg.BlobBaseURL, "/") {
+			blobHandler = store.Handler()
+		}
+	case "", "none":
+		log.Printf("Avatar upload disabled (no blob store)")
+	default:
+		log.Fatalf("Unknown blob store: %s", cfg.BlobStore)
+	}
+
+	// 오타 허용 검색 인덱스 (인스턴스마다 메모리에 유지, 시작 시 전체 구성)
+	if cfg.SearchIndexEnabled {
+		userService.WithSearchIndex(search.NewIndex())
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		if _, err := userService.RebuildSearchIndex(ctx); err != nil {
+			log.Printf("Failed to build search index: %v", err)
+		}
+		cancel()
+		jobs.Start(context.Background(), jobs.Job{
+			Name:     "search-index-rebuild",
+			Interval: cfg.SearchIndexRebuildInterval,
+			Run:      userService.RefreshSearchIndex,
+		})
+	}
+
+	// 백그라운드 작업 시작
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "suspension-expiry",
+		Interval: cfg.SuspensionCheckInterval,
+		Run:      userService.ExpireSuspensions,
+	})
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "deletion-purge",
+		Interval: cfg.PurgeInterval,
+		Run:      userService.RunPurge,
+	})
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "export-cleanup",
+		Interval: cfg.PurgeInterval,
+		Run:      userService.DeleteExpiredExports,
+	})
+	jobs.Start(context.Background(), jobs.Job{
+		Name:     "verification-cleanup",
+		Interval: cfg.PurgeInterval,
+		Run:      userService.DeleteExpiredVerifications,
+	})
 
 	// 핸들러 초기화
-	userHandler := handlers.NewUserHandler(userService, cfg.JWTSecret)
+	messages, err := i18n.LoadBundle(cfg.LocalesDir, cfg.DefaultLanguage)
+	if err != nil {
+		log.Fatalf("Failed to load message catalogs: %v", err)
+	}
+	userHandler := handlers.NewUserHandler(userService, cfg.JWTSecret).
+		WithMessages(messages)
 
 	// 라우터 설정
 	r := mux.NewRouter()
@@ -37,9 +157,18 @@ func main() {
 	// JWT 미들웨어 설정
 	auth := middleware.NewJWTMiddleware(cfg.JWTSecret)
 
+	// 로컬 저장소의 업로드 파일 (BLOB_BASE_URL이 외부 주소면 그쪽에서 제공)
+	if blobHandler != nil {
+		r.PathPrefix(strings.TrimSuffix(cfg.BlobBaseURL, "/")+"/").Handler(blobHandler).Methods("GET", "HEAD")
+	}
+
 	// Public endpoints (인증 불필요)
 	publicRouter := r.PathPrefix("/api/v1/public").Subrouter()
+	publicRouter.Use(auth.OptionalJWT) // 로그인한 본인/관리자에게는 비공개 필드까지 노출
 	publicRouter.HandleFunc("/users/search", userHandler.SearchProfiles).Methods("GET")
+	publicRouter.HandleFunc("/users/search/fuzzy", userHandler.FuzzySearchProfiles).Methods("GET")
+	publicRouter.HandleFunc("/users/nearby", userHandler.NearbyProfiles).Methods("GET")
+	publicRouter.HandleFunc("/users/autocomplete", userHandler.AutocompleteProfiles).Methods("GET")
 	publicRouter.HandleFunc("/users/username/{username}", userHandler.GetProfileByUsername).Methods("GET")
 	publicRouter.HandleFunc("/users/{id}", userHandler.GetProfile).Methods("GET")
 
@@ -47,14 +176,42 @@ func main() {
 	protectedRouter := r.PathPrefix("/api/v1").Subrouter()
 	protectedRouter.Use(auth.ValidateJWT)
 	protectedRouter.HandleFunc("/users", userHandler.CreateProfile).Methods("POST")
+	// /users/me는 /users/{id}보다 먼저 등록해야 함
+	protectedRouter.HandleFunc("/users/me", userHandler.GetMyProfile).Methods("GET")
+	protectedRouter.HandleFunc("/users/me", userHandler.UpdateMyProfile).Methods("PUT")
+	protectedRouter.HandleFunc("/users/me", userHandler.PatchMyProfile).Methods("PATCH")
+	protectedRouter.HandleFunc("/users/me", userHandler.DeleteMyProfile).Methods("DELETE")
+	protectedRouter.HandleFunc("/users/me/restore", userHandler.RestoreMyProfile).Methods("POST")
+	protectedRouter.HandleFunc("/users/me/avatar", userHandler.UploadMyAvatar).Methods("PUT")
+	protectedRouter.HandleFunc("/users/me/avatar", userHandler.DeleteMyAvatar).Methods("DELETE")
+	protectedRouter.HandleFunc("/users/me/addresses", userHandler.ListMyAddresses).Methods("GET")
+	protectedRouter.HandleFunc("/users/me/addresses", userHandler.CreateMyAddress).Methods("POST")
+	protectedRouter.HandleFunc("/users/me/addresses/{addressId}", userHandler.GetMyAddress).Methods("GET")
+	protectedRouter.HandleFunc("/users/me/addresses/{addressId}", userHandler.UpdateMyAddress).Methods("PUT")
+	protectedRouter.HandleFunc("/users/me/addresses/{addressId}", userHandler.DeleteMyAddress).Methods("DELETE")
+	protectedRouter.HandleFunc("/users/me/addresses/{addressId}/formatted", userHandler.FormatMyAddress).Methods("GET")
+	protectedRouter.HandleFunc("/users/me/phone/verification", userHandler.RequestPhoneVerification).Methods("POST")
+	protectedRouter.HandleFunc("/users/me/phone/verification/confirm", userHandler.ConfirmPhoneVerification).Methods("POST")
+	protectedRouter.HandleFunc("/users/me/exports", userHandler.CreateMyExport).Methods("POST")
+	protectedRouter.HandleFunc("/users/me/exports/{exportId}", userHandler.GetMyExport).Methods("GET")
+	protectedRouter.HandleFunc("/users/me/exports/{exportId}/download", userHandler.DownloadMyExport).Methods("GET")
 	protectedRouter.HandleFunc("/users/{id}", userHandler.UpdateProfile).Methods("PUT")
+	protectedRouter.HandleFunc("/users/{id}", userHandler.PatchProfile).Methods("PATCH")
 	protectedRouter.HandleFunc("/users/{id}", userHandler.DeleteProfile).Methods("DELETE")
+	protectedRouter.HandleFunc("/users/{id}/restore", userHandler.RestoreProfile).Methods("POST")
 
 	// Admin endpoints (관리자 권한 필요)
 	adminRouter := r.PathPrefix("/api/v1/admin").Subrouter()
 	adminRouter.Use(auth.ValidateJWT)
 	adminRouter.Use(auth.RequireRole("admin"))
-	// 관리자용 API 엔드포인트 추가
+	adminRouter.HandleFunc("/users", userHandler.AdminListProfiles).Methods("GET")
+	adminRouter.HandleFunc("/users/{id}", userHandler.AdminGetProfile).Methods("GET")
+	adminRouter.HandleFunc("/users/{id}/status", userHandler.AdminUpdateStatus).Methods("PUT")
+	adminRouter.HandleFunc("/users/{id}/status-history", userHandler.AdminGetStatusHistory).Methods("GET")
+	adminRouter.HandleFunc("/users/{id}/erase", userHandler.AdminEraseProfile).Methods("POST")
+	adminRouter.HandleFunc("/purge", userHandler.AdminRunPurge).Methods("POST")
+	// 검색 인덱스는 인스턴스별 메모리에 있어 요청을 받은 인스턴스만 재구성 (나머지는 주기적 재구성으로 반영)
+	adminRouter.HandleFunc("/search-index/rebuild", userHandler.AdminRebuildSearchIndex).Methods("POST")
 
 	// CORS 미들웨어 추가
 	corsMiddleware := middleware.NewCORS()
@@ -69,3 +226,86 @@ func main() {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
+
+// repositories 저장소 드라이버별로 생성되는 리포지토리 묶음
+type repositories struct {
+	users         repository.UserRepository
+	exports       repository.ExportRepository
+	verifications repository.VerificationRepository
+}
+
+// newRepositories 설정된 저장소 드라이버에 맞는 리포지토리 생성
+func newRepositories(cfg *config.Config) (*repositories, error) {
+	switch cfg.StorageDriver {
+	case "mongodb":
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		db, err := mongodb.Connect(ctx, cfg.MongoURI)
+		if err != nil {
+			return nil, err
+		}
+		users, err := mongodb.NewUserRepository(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		exports, err := mongodb.NewExportRepository(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		verifications, err := mongodb.NewVerificationRepository(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		return &repositories{users: users, exports: exports, verifications: verifications}, nil
+	case "memory":
+		log.Printf("Using in-memory repositories (data is not persisted)")
+		return &repositories{
+			users:         memory.NewUserRepository(),
+			exports:       memory.NewExportRepository(),
+			verifications: memory.NewVerificationRepository(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", cfg.StorageDriver)
+	}
+}
+
+// verificationPolicy 전화번호 인증번호 정책 설정값 (0이면 기본값 사용)
+func verificationPolicy(cfg *config.Config) services.VerificationPolicy {
+	return services.VerificationPolicy{
+		CodeTTL:        cfg.OTPTTL,
+		ResendInterval: cfg.OTPResendInterval,
+		MaxPerHour:     cfg.OTPMaxPerHour,
+		MaxAttempts:    cfg.OTPMaxAttempts,
+	}
+}
+
+// avatarPolicy 프로필 이미지 업로드 정책 설정값 (0이면 기본값 사용)
+func avatarPolicy(cfg *config.Config) services.AvatarPolicy {
+	return services.AvatarPolicy{
+		MaxBytes:       cfg.AvatarMaxBytes,
+		MaxSide:        cfg.AvatarMaxSide,
+		ThumbnailSizes: cfg.AvatarThumbnails,
+	}
+}
+
+// validationRules 기본 검증 규칙에 설정값 적용
+func validationRules(cfg *config.Config) (*utils.ValidationRules, error) {
+	rules := utils.DefaultValidationRules()
+	if len(cfg.UsernameScripts) > 0 {
+		rules.Username.Scripts = cfg.UsernameScripts
+	}
+	if cfg.UsernameMinLength > 0 {
+		rules.Username.MinLength = cfg.UsernameMinLength
+	}
+	if cfg.UsernameMaxLength > 0 {
+		rules.Username.MaxLength = cfg.UsernameMaxLength
+	}
+	if len(cfg.NameScripts) > 0 {
+		rules.Name.Scripts = cfg.NameScripts
+	}
+	if cfg.NameMaxLength > 0 {
+		rules.Name.MaxLength = cfg.NameMaxLength
+	}
+	return rules, rules.Check()
+}
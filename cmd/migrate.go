@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/config"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/migrations"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository/mongodb"
+)
+
+const migrateUsage = "usage: migrate up [version] | down [steps] | status"
+
+// runMigrate migrate 서브커맨드 실행
+func runMigrate(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	db, err := mongodb.Connect(ctx, cfg.MongoURI)
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+	defer db.Client().Disconnect(context.Background())
+
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		target, err := optionalIntArg(args[1:], 0)
+		if err != nil {
+			return err
+		}
+		applied, err := migrator.Up(ctx, target)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Applied %d migration(s): %v\n", len(applied), applied)
+
+	case "down":
+		steps, err := optionalIntArg(args[1:], 1)
+		if err != nil {
+			return err
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Reverted %d migration(s): %v\n", len(reverted), reverted)
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tSTATUS\tAPPLIED AT\tDESCRIPTION")
+		for _, s := range statuses {
+			state, appliedAt := "pending", "-"
+			if s.Applied {
+				state, appliedAt = "applied", s.AppliedAt.Format(time.RFC3339)
+			}
+			if !s.Reversible {
+				state += " (irreversible)"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.Version, state, appliedAt, s.Description)
+		}
+		w.Flush()
+
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	return nil
+}
+
+func optionalIntArg(args []string, defaultValue int) (int, error) {
+	if len(args) == 0 {
+		return defaultValue, nil
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid number %q: %s", args[0], migrateUsage)
+	}
+	return n, nil
+}
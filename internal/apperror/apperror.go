@@ -0,0 +1,72 @@
+package apperror
+
+import "errors"
+
+// Kind 에러 종류 (전송 계층이 응답 상태를 결정하는 기준)
+type Kind int
+
+const (
+	Internal           Kind = iota // 분류되지 않은 서버 내부 에러
+	NotFound                       // 대상 리소스가 없음
+	Conflict                       // 현재 상태와 충돌 (중복, 동시 수정, 허용되지 않는 상태 전환)
+	Validation                     // 요청 값이 잘못됨
+	Unauthorized                   // 인증 정보 없음
+	Forbidden                      // 권한 없음
+	Unavailable                    // 저장소 등 외부 의존성 장애
+	PreconditionFailed             // 조건부 요청의 조건 불일치
+	Gone                           // 더 이상 사용할 수 없는 리소스
+	Unsupported                    // 지원하지 않는 요청 형식
+	TooManyRequests                // 요청 횟수 제한 초과
+)
+
+// Error 종류와 안정적인 에러 코드를 가진 도메인 에러
+// 센티넬로 선언해 errors.Is로 비교하거나 fmt.Errorf("%w")로 문맥을 덧붙여 사용
+type Error struct {
+	Kind    Kind
+	Code    string // 클라이언트가 분기할 수 있는 고정 코드 (예: username_taken)
+	Message string
+	Err     error // 원인 (Unavailable, Internal 에러의 내부 상세)
+}
+
+// New 도메인 에러 생성
+func New(kind Kind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+// Wrap 원인 에러를 감싼 도메인 에러 생성
+func Wrap(kind Kind, code, message string, err error) *Error {
+	return &Error{Kind: kind, Code: code, Message: message, Err: err}
+}
+
+// ServiceUnavailable 외부 의존성 장애를 감싼 에러 생성 (원인은 로그에만 남기고 응답에는 노출하지 않음)
+func ServiceUnavailable(err error) *Error {
+	return Wrap(Unavailable, "service_unavailable", "service temporarily unavailable", err)
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// As 에러 체인에서 도메인 에러를 찾아 반환 (없으면 nil)
+func As(err error) *Error {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	return nil
+}
+
+// KindOf 에러 체인에 있는 도메인 에러의 종류 (도메인 에러가 아니면 Internal)
+func KindOf(err error) Kind {
+	if appErr := As(err); appErr != nil {
+		return appErr.Kind
+	}
+	return Internal
+}
@@ -1,15 +1,58 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 )
 
 type Config struct {
 	ServerPort     string   `mapstructure:"SERVER_PORT"`
+	StorageDriver  string   `mapstructure:"STORAGE_DRIVER"` // mongodb, memory
 	MongoURI       string   `mapstructure:"MONGO_URI"`
 	JWTSecret      string   `mapstructure:"JWT_SECRET"`       // Auth Service와 동일한 시크릿 사용
 	AuthServiceURL string   `mapstructure:"AUTH_SERVICE_URL"` // Auth Service 연동용
 	AllowedOrigins []string `mapstructure:"ALLOWED_ORIGINS"`  // CORS 허용 도메인
+
+	SuspensionCheckInterval time.Duration `mapstructure:"SUSPENSION_CHECK_INTERVAL"` // 기간 정지 만료 확인 주기 (0이면 비활성)
+	DeletionGracePeriod     time.Duration `mapstructure:"DELETION_GRACE_PERIOD"`     // 탈퇴 후 복구 가능 기간
+	PurgeInterval           time.Duration `mapstructure:"PURGE_INTERVAL"`            // 유예 기간 지난 탈퇴 프로필 파기 주기 (0이면 비활성)
+	ExportRetention         time.Duration `mapstructure:"EXPORT_RETENTION"`          // 데이터 내보내기 결과 파일 보관 기간
+
+	// 지오코딩 (static: 내장 도시 좌표 표, none: 비활성)
+	Geocoder      string `mapstructure:"GEOCODER"`
+	GeocoderTable string `mapstructure:"GEOCODER_TABLE"` // 내장 표에 추가할 도시 CSV (country,city,lat,lng)
+
+	// 전화번호 인증 (SMS_SENDER: log는 서버 로그, file은 SMS_OUTBOX 파일에 기록, none은 비활성)
+	SMSSender         string        `mapstructure:"SMS_SENDER"`
+	SMSOutbox         string        `mapstructure:"SMS_OUTBOX"`
+	OTPTTL            time.Duration `mapstructure:"OTP_TTL"`             // 인증번호 유효 시간
+	OTPResendInterval time.Duration `mapstructure:"OTP_RESEND_INTERVAL"` // 재발송 최소 간격
+	OTPMaxPerHour     int           `mapstructure:"OTP_MAX_PER_HOUR"`    // 사용자, 전화번호별 시간당 발송 한도
+	OTPMaxAttempts    int           `mapstructure:"OTP_MAX_ATTEMPTS"`    // 인증번호 하나의 확인 시도 한도
+
+	// 프로필 이미지 업로드 (BLOB_STORE: local은 BLOB_DIR에 저장하고 BLOB_BASE_URL로 제공, none은 비활성)
+	BlobStore        string `mapstructure:"BLOB_STORE"`
+	BlobDir          string `mapstructure:"BLOB_DIR"`
+	BlobBaseURL      string `mapstructure:"BLOB_BASE_URL"`     // /로 시작하면 이 서버가 파일을 직접 제공
+	AvatarMaxBytes   int64  `mapstructure:"AVATAR_MAX_BYTES"`  // 업로드 파일 크기 한도
+	AvatarMaxSide    int    `mapstructure:"AVATAR_MAX_SIDE"`   // 저장할 원본 이미지의 가로, 세로 최대값
+	AvatarThumbnails []int  `mapstructure:"AVATAR_THUMBNAILS"` // 정사각형 썸네일 크기 목록
+
+	// 오타 허용 검색 인덱스 (인스턴스가 여러 개면 다른 인스턴스의 변경은 재구성 주기마다 반영)
+	SearchIndexEnabled         bool          `mapstructure:"SEARCH_INDEX_ENABLED"`
+	SearchIndexRebuildInterval time.Duration `mapstructure:"SEARCH_INDEX_REBUILD_INTERVAL"` // 전체 재구성 주기 (0이면 비활성)
+
+	// 프로필 필드 검증 규칙 (문자 체계는 유니코드 스크립트 이름, 예: Latin, Hangul, Han)
+	UsernameScripts   []string `mapstructure:"USERNAME_SCRIPTS"`
+	UsernameMinLength int      `mapstructure:"USERNAME_MIN_LENGTH"`
+	UsernameMaxLength int      `mapstructure:"USERNAME_MAX_LENGTH"`
+	NameScripts       []string `mapstructure:"NAME_SCRIPTS"`
+	NameMaxLength     int      `mapstructure:"NAME_MAX_LENGTH"`
+
+	// 응답 메시지 언어 (LOCALES_DIR의 <언어 태그>.json으로 언어 추가 또는 메시지 덮어쓰기)
+	DefaultLanguage string `mapstructure:"DEFAULT_LANGUAGE"` // Accept-Language와 맞는 언어가 없을 때 사용
+	LocalesDir      string `mapstructure:"LOCALES_DIR"`      // 비어 있으면 내장 카탈로그만 사용
 }
 
 func LoadConfig() (*Config, error) {
@@ -18,7 +61,35 @@ func LoadConfig() (*Config, error) {
 
 	// 기본값 설정
 	viper.SetDefault("SERVER_PORT", "8002")
+	viper.SetDefault("STORAGE_DRIVER", "mongodb")
 	viper.SetDefault("AUTH_SERVICE_URL", "http://auth-service:8001")
+	viper.SetDefault("SUSPENSION_CHECK_INTERVAL", "1m")
+	viper.SetDefault("DELETION_GRACE_PERIOD", "720h") // 30일
+	viper.SetDefault("PURGE_INTERVAL", "1h")
+	viper.SetDefault("EXPORT_RETENTION", "24h")
+	viper.SetDefault("GEOCODER", "static")
+	viper.SetDefault("GEOCODER_TABLE", "")
+	viper.SetDefault("SMS_SENDER", "none") // log, file은 인증번호가 그대로 남으므로 개발용
+	viper.SetDefault("SMS_OUTBOX", "sms-outbox.jsonl")
+	viper.SetDefault("OTP_TTL", "10m")
+	viper.SetDefault("OTP_RESEND_INTERVAL", "1m")
+	viper.SetDefault("OTP_MAX_PER_HOUR", 5)
+	viper.SetDefault("OTP_MAX_ATTEMPTS", 5)
+	viper.SetDefault("BLOB_STORE", "local")
+	viper.SetDefault("BLOB_DIR", "uploads")
+	viper.SetDefault("BLOB_BASE_URL", "/media")
+	viper.SetDefault("AVATAR_MAX_BYTES", 5<<20) // 5MB
+	viper.SetDefault("AVATAR_MAX_SIDE", 1024)
+	viper.SetDefault("AVATAR_THUMBNAILS", "256,128,64")
+	viper.SetDefault("SEARCH_INDEX_ENABLED", true)
+	viper.SetDefault("SEARCH_INDEX_REBUILD_INTERVAL", "15m")
+	viper.SetDefault("USERNAME_SCRIPTS", "Latin")
+	viper.SetDefault("USERNAME_MIN_LENGTH", 3)
+	viper.SetDefault("USERNAME_MAX_LENGTH", 30)
+	viper.SetDefault("NAME_SCRIPTS", "Latin,Hangul,Han,Hiragana,Katakana")
+	viper.SetDefault("NAME_MAX_LENGTH", 50)
+	viper.SetDefault("DEFAULT_LANGUAGE", "en")
+	viper.SetDefault("LOCALES_DIR", "")
 
 	if err := viper.ReadInConfig(); err != nil {
 		// .env 파일이 없어도 환경변수로 실행 가능하게
@@ -0,0 +1,130 @@
+package geocoding
+
+import (
+	"context"
+	"embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+//go:embed data/*.csv
+var data embed.FS
+
+// StaticGeocoder 내장 도시 좌표 표로 도시 단위 좌표를 찾는 지오코더 (외부 API 호출 없음)
+// 도시와 국가는 대소문자, 발음 구별 기호를 무시하고 비교하며, 국가는 ISO 코드 또는 이름으로 지정
+type StaticGeocoder struct {
+	countries map[string]string           // 접은 국가 이름/코드 → ISO 코드
+	cities    map[string]*models.GeoPoint // "ISO 코드|접은 도시 이름" → 좌표
+}
+
+// NewStaticGeocoder 내장 표로 지오코더 생성
+// path가 있으면 같은 형식(country,city,lat,lng)의 CSV를 읽어 도시를 추가하거나 좌표를 덮어씀
+func NewStaticGeocoder(path string) (*StaticGeocoder, error) {
+	g := &StaticGeocoder{
+		countries: make(map[string]string),
+		cities:    make(map[string]*models.GeoPoint),
+	}
+
+	countries, err := data.Open("data/countries.csv")
+	if err != nil {
+		return nil, err
+	}
+	defer countries.Close()
+	if err := g.loadCountries(countries); err != nil {
+		return nil, fmt.Errorf("countries.csv: %w", err)
+	}
+
+	cities, err := data.Open("data/cities.csv")
+	if err != nil {
+		return nil, err
+	}
+	defer cities.Close()
+	if err := g.loadCities(cities); err != nil {
+		return nil, fmt.Errorf("cities.csv: %w", err)
+	}
+
+	if path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		if err := g.loadCities(file); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return g, nil
+}
+
+// Geocode 주소의 도시와 국가로 좌표 조회 (표에 없으면 nil)
+func (g *StaticGeocoder) Geocode(ctx context.Context, addr models.Address) (*models.GeoPoint, error) {
+	code, ok := g.countries[utils.FoldSearchKey(addr.Country)]
+	if !ok {
+		return nil, nil
+	}
+	point, ok := g.cities[cityKey(code, addr.City)]
+	if !ok {
+		return nil, nil
+	}
+	return models.NewGeoPoint(point.Lat(), point.Lng()), nil
+}
+
+func (g *StaticGeocoder) loadCountries(r io.Reader) error {
+	return readTable(r, 2, func(row []string) error {
+		code := strings.ToUpper(strings.TrimSpace(row[0]))
+		g.countries[utils.FoldSearchKey(code)] = code
+		g.countries[utils.FoldSearchKey(row[1])] = code
+		return nil
+	})
+}
+
+func (g *StaticGeocoder) loadCities(r io.Reader) error {
+	return readTable(r, 4, func(row []string) error {
+		code := strings.ToUpper(strings.TrimSpace(row[0]))
+		lat, err := strconv.ParseFloat(strings.TrimSpace(row[2]), 64)
+		if err != nil {
+			return fmt.Errorf("invalid latitude %q", row[2])
+		}
+		lng, err := strconv.ParseFloat(strings.TrimSpace(row[3]), 64)
+		if err != nil {
+			return fmt.Errorf("invalid longitude %q", row[3])
+		}
+		point := models.NewGeoPoint(lat, lng)
+		if !point.Valid() {
+			return fmt.Errorf("coordinates out of range: %s, %s", row[2], row[3])
+		}
+		g.countries[utils.FoldSearchKey(code)] = code
+		g.cities[cityKey(code, row[1])] = point
+		return nil
+	})
+}
+
+// readTable 머리글 행을 건너뛰고 각 행을 fn에 전달
+func readTable(r io.Reader, columns int, fn func(row []string) error) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = columns
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return err
+	}
+	for i, row := range rows {
+		if i == 0 {
+			continue
+		}
+		if err := fn(row); err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func cityKey(code, city string) string {
+	return code + "|" + utils.FoldSearchKey(city)
+}
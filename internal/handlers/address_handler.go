@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+// ListMyAddresses 로그인 사용자의 주소록 조회
+func (h *UserHandler) ListMyAddresses(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	book, err := h.userService.ListAddresses(r.Context(), authID)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(book)
+}
+
+// CreateMyAddress 주소록에 주소 추가 (201 응답)
+func (h *UserHandler) CreateMyAddress(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.AddressRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendServiceError(w, r, errInvalidBody)
+		return
+	}
+
+	entry, err := h.userService.CreateAddress(r.Context(), authID, &req)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/api/v1/users/me/addresses/"+entry.ID.Hex())
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(entry)
+}
+
+// GetMyAddress 주소록 항목 조회
+func (h *UserHandler) GetMyAddress(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+	addressID, ok := h.addressIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	entry, err := h.userService.GetAddress(r.Context(), authID, addressID)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(entry)
+}
+
+// FormatMyAddress 주소록 항목을 받는 국가의 주소 서식으로 변환
+// 쿼리: origin(보내는 국가, 다르면 국가 이름 줄 추가)
+func (h *UserHandler) FormatMyAddress(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+	addressID, ok := h.addressIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	formatted, err := h.userService.FormatAddress(r.Context(), authID, addressID, r.URL.Query().Get("origin"))
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(formatted)
+}
+
+// UpdateMyAddress 주소록 항목 교체
+func (h *UserHandler) UpdateMyAddress(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+	addressID, ok := h.addressIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.AddressRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendServiceError(w, r, errInvalidBody)
+		return
+	}
+
+	entry, err := h.userService.UpdateAddress(r.Context(), authID, addressID, &req)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(entry)
+}
+
+// DeleteMyAddress 주소록 항목 삭제
+func (h *UserHandler) DeleteMyAddress(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+	addressID, ok := h.addressIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.DeleteAddress(r.Context(), authID, addressID); err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	h.sendMessage(w, r, http.StatusOK, "address_deleted")
+}
+
+func (h *UserHandler) addressIDFromRequest(w http.ResponseWriter, r *http.Request) (primitive.ObjectID, bool) {
+	addressID, err := primitive.ObjectIDFromHex(mux.Vars(r)["addressId"])
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidAddressID)
+		return primitive.NilObjectID, false
+	}
+	return addressID, true
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/services"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// AdminListProfiles 관리자용 프로필 목록 조회
+// 쿼리: status, country, email, username, created_from, created_to, page, page_size, sort(-created_at 형식)
+func (h *UserHandler) AdminListProfiles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var invalid []services.FieldError
+	filter := parseProfileFilter(query, &invalid)
+	opts := parseListOptions(query, &invalid)
+	if len(invalid) > 0 {
+		h.sendValidationError(w, r, invalid...)
+		return
+	}
+
+	result, err := h.userService.ListProfiles(r.Context(), filter, opts)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// AdminGetProfile 관리자용 프로필 조회 (비공개 필드 포함)
+func (h *UserHandler) AdminGetProfile(w http.ResponseWriter, r *http.Request) {
+	userID, err := primitive.ObjectIDFromHex(mux.Vars(r)["id"])
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidUserID)
+		return
+	}
+
+	profile, err := h.userService.AdminGetProfile(r.Context(), userID)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	writeProfile(w, profile)
+}
+
+// AdminUpdateStatus 관리자의 프로필 상태 변경
+func (h *UserHandler) AdminUpdateStatus(w http.ResponseWriter, r *http.Request) {
+	claims, err := utils.GetUserFromContext(r.Context())
+	if err != nil {
+		h.sendServiceError(w, r, unauthorized(err))
+		return
+	}
+
+	userID, err := primitive.ObjectIDFromHex(mux.Vars(r)["id"])
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidUserID)
+		return
+	}
+
+	var req models.UpdateStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendServiceError(w, r, errInvalidBody)
+		return
+	}
+
+	if err := h.userService.ChangeStatus(r.Context(), userID, viewerFromClaims(claims), &req); err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	h.sendMessage(w, r, http.StatusOK, "status_updated")
+}
+
+// AdminGetStatusHistory 프로필 상태 변경 이력 조회
+func (h *UserHandler) AdminGetStatusHistory(w http.ResponseWriter, r *http.Request) {
+	userID, err := primitive.ObjectIDFromHex(mux.Vars(r)["id"])
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidUserID)
+		return
+	}
+
+	history, err := h.userService.GetStatusHistory(r.Context(), userID)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(history)
+}
+
+// AdminEraseProfile 프로필 개인정보 즉시 파기
+func (h *UserHandler) AdminEraseProfile(w http.ResponseWriter, r *http.Request) {
+	claims, err := utils.GetUserFromContext(r.Context())
+	if err != nil {
+		h.sendServiceError(w, r, unauthorized(err))
+		return
+	}
+
+	userID, err := primitive.ObjectIDFromHex(mux.Vars(r)["id"])
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidUserID)
+		return
+	}
+
+	report, err := h.userService.EraseProfile(r.Context(), userID, viewerFromClaims(claims))
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
+// AdminRunPurge 유예 기간이 지난 탈퇴 프로필 파기 즉시 실행
+func (h *UserHandler) AdminRunPurge(w http.ResponseWriter, r *http.Request) {
+	report, err := h.userService.PurgeExpiredDeletions(r.Context())
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
+// AdminRebuildSearchIndex 요청을 받은 인스턴스의 오타 허용 검색 인덱스 즉시 재구성 (응답의 instance로 확인)
+func (h *UserHandler) AdminRebuildSearchIndex(w http.ResponseWriter, r *http.Request) {
+	report, err := h.userService.RebuildSearchIndex(r.Context())
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
+func parseProfileFilter(query url.Values, invalid *[]services.FieldError) models.ProfileFilter {
+	return models.ProfileFilter{
+		Status:      query.Get("status"),
+		Country:     strings.TrimSpace(query.Get("country")),
+		Email:       strings.TrimSpace(query.Get("email")),
+		Username:    strings.TrimSpace(query.Get("username")),
+		CreatedFrom: parseTimeParam(query, "created_from", invalid),
+		CreatedTo:   parseTimeParam(query, "created_to", invalid),
+	}
+}
+
+func parseListOptions(query url.Values, invalid *[]services.FieldError) models.ListOptions {
+	var opts models.ListOptions
+	opts.Page = parsePositiveParam(query, "page", invalid)
+	opts.PageSize = parsePositiveParam(query, "page_size", invalid)
+	if sort := query.Get("sort"); sort != "" {
+		opts.SortDesc = strings.HasPrefix(sort, "-")
+		opts.SortBy = strings.TrimPrefix(sort, "-")
+	}
+	return opts
+}
+
+// parsePositiveParam 1 이상의 정수 파라미터 파싱 (없으면 0)
+func parsePositiveParam(query url.Values, name string, invalid *[]services.FieldError) int64 {
+	v := query.Get(name)
+	if v == "" {
+		return 0
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 1 {
+		*invalid = append(*invalid, services.FieldError{
+			Field:   name,
+			Code:    utils.ViolationInvalidFormat,
+			Message: fmt.Sprintf("invalid %s: %s", name, v),
+		})
+		return 0
+	}
+	return n
+}
+
+// parseFloatParam 실수 파라미터 파싱 (없으면 nil)
+func parseFloatParam(query url.Values, name string, invalid *[]services.FieldError) *float64 {
+	v := query.Get(name)
+	if v == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		*invalid = append(*invalid, services.FieldError{
+			Field:   name,
+			Code:    utils.ViolationInvalidFormat,
+			Message: fmt.Sprintf("invalid %s: %s", name, v),
+		})
+		return nil
+	}
+	return &f
+}
+
+// parseTimeParam RFC3339 또는 YYYY-MM-DD 형식의 시간 파라미터 파싱
+func parseTimeParam(query url.Values, name string, invalid *[]services.FieldError) *time.Time {
+	v := query.Get(name)
+	if v == "" {
+		return nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return &t
+	}
+	if t, err := time.Parse("2006-01-02", v); err == nil {
+		return &t
+	}
+	*invalid = append(*invalid, services.FieldError{
+		Field:   name,
+		Code:    utils.ViolationInvalidFormat,
+		Message: fmt.Sprintf("invalid %s: use RFC3339 or YYYY-MM-DD", name),
+	})
+	return nil
+}
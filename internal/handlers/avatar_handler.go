@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/services"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// avatarFormField 프로필 이미지 파일을 담는 multipart 필드 이름
+const avatarFormField = "avatar"
+
+// avatarFormOverhead 업로드 요청 본문 크기 제한에서 파일 외에 허용하는 크기 (multipart 경계, 헤더, 다른 필드)
+const avatarFormOverhead = 64 << 10
+
+// UploadMyAvatar 로그인 사용자의 프로필 이미지 업로드 (multipart/form-data의 avatar 필드)
+func (h *UserHandler) UploadMyAvatar(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.userService.AvatarMaxBytes()+avatarFormOverhead)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		h.sendServiceError(w, r, errMultipartRequired)
+		return
+	}
+
+	// 파일을 임시 파일로 받지 않고 avatar 필드를 찾아 바로 서비스에 넘김 (파일 크기 제한은 서비스에서 적용)
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			h.sendValidationError(w, r, services.FieldError{
+				Field:   avatarFormField,
+				Code:    utils.ViolationRequired,
+				Message: "avatar image is required",
+			})
+			return
+		}
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.sendServiceError(w, r, errBodyTooLarge)
+			return
+		}
+		if err != nil {
+			h.sendServiceError(w, r, errInvalidMultipart)
+			return
+		}
+		if part.FormName() != avatarFormField {
+			continue
+		}
+
+		upload := &models.AvatarUpload{ContentType: part.Header.Get("Content-Type"), File: part}
+		result, err := h.userService.UploadAvatar(r.Context(), authID, upload)
+		if err != nil {
+			h.sendServiceError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+}
+
+// DeleteMyAvatar 로그인 사용자의 프로필 이미지 제거
+func (h *UserHandler) DeleteMyAvatar(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.DeleteAvatar(r.Context(), authID); err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	h.sendMessage(w, r, http.StatusOK, "avatar_deleted")
+}
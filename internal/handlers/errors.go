@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/apperror"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/i18n"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/services"
+)
+
+type ErrorResponse struct {
+	Error  string                `json:"error"`            // 요청 언어로 번역된 메시지
+	Code   string                `json:"code"`             // 클라이언트가 분기할 수 있는 고정 코드
+	Detail string                `json:"detail,omitempty"` // 번역되지 않는 상세 정보 (개발자용)
+	Fields []services.FieldError `json:"fields,omitempty"` // 검증 실패 시 필드별 위반 사항
+}
+
+// 핸들러에서 요청을 해석하다 발생하는 에러
+var (
+	errInvalidBody      = apperror.New(apperror.Validation, "invalid_request_body", "invalid request body")
+	errInvalidUserID    = apperror.New(apperror.Validation, "invalid_user_id", "invalid user ID")
+	errInvalidAuthID    = apperror.New(apperror.Validation, "invalid_auth_id", "invalid auth ID")
+	errInvalidExportID  = apperror.New(apperror.Validation, "invalid_export_id", "invalid export ID")
+	errInvalidAddressID = apperror.New(apperror.Validation, "invalid_address_id", "invalid address ID")
+
+	errMultipartRequired = apperror.New(apperror.Unsupported, "multipart_required", "request must be multipart/form-data")
+	errInvalidMultipart  = apperror.New(apperror.Validation, "invalid_multipart_body", "invalid multipart request body")
+	errBodyTooLarge      = apperror.New(apperror.Validation, "request_body_too_large", "request body is too large")
+)
+
+// kindStatus 도메인 에러 종류별 HTTP 상태 코드
+var kindStatus = map[apperror.Kind]int{
+	apperror.NotFound:           http.StatusNotFound,
+	apperror.Conflict:           http.StatusConflict,
+	apperror.Validation:         http.StatusBadRequest,
+	apperror.Unauthorized:       http.StatusUnauthorized,
+	apperror.Forbidden:          http.StatusForbidden,
+	apperror.Unavailable:        http.StatusServiceUnavailable,
+	apperror.PreconditionFailed: http.StatusPreconditionFailed,
+	apperror.Gone:               http.StatusGone,
+	apperror.Unsupported:        http.StatusUnsupportedMediaType,
+	apperror.TooManyRequests:    http.StatusTooManyRequests,
+}
+
+// unauthorized 인증 정보를 읽지 못한 에러
+func unauthorized(err error) error {
+	return apperror.Wrap(apperror.Unauthorized, "unauthorized", "unauthorized", err)
+}
+
+// localizer 요청의 Accept-Language에 맞는 메시지 조회기
+func (h *UserHandler) localizer(r *http.Request) *i18n.Localizer {
+	return h.messages.Localizer(r.Header.Get("Accept-Language"))
+}
+
+// sendMessage 요청 언어로 번역된 성공 메시지 응답 전송
+func (h *UserHandler) sendMessage(w http.ResponseWriter, r *http.Request, status int, key string) {
+	loc := h.localizer(r)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Language", loc.Language())
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": loc.Text("message."+key, key, nil),
+	})
+}
+
+func (h *UserHandler) sendErrorResponse(w http.ResponseWriter, loc *i18n.Localizer, status int, resp ErrorResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Language", loc.Language())
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// sendValidationError 필드별 위반 사항을 요청 언어로 번역해 400 응답 전송
+func (h *UserHandler) sendValidationError(w http.ResponseWriter, r *http.Request, fields ...services.FieldError) {
+	loc := h.localizer(r)
+	localized := make([]services.FieldError, len(fields))
+	for i, field := range fields {
+		localized[i] = localizeField(loc, field)
+	}
+
+	h.sendErrorResponse(w, loc, http.StatusBadRequest, ErrorResponse{
+		Error:  loc.Text("error.validation_failed", "validation failed", nil),
+		Code:   "validation_failed",
+		Fields: localized,
+	})
+}
+
+// localizeField 위반 메시지 번역 (필드 전용 메시지가 있으면 우선 사용, 없으면 원래 메시지 유지)
+func localizeField(loc *i18n.Localizer, field services.FieldError) services.FieldError {
+	params := map[string]string{"field": loc.Text("field."+field.Field, field.Field, nil)}
+	for name, value := range field.Params {
+		params[name] = value
+	}
+
+	if message, ok := loc.Message("violation."+field.Code+"."+field.Field, params); ok {
+		field.Message = message
+	} else if message, ok := loc.Message("violation."+field.Code, params); ok {
+		field.Message = message
+	}
+	return field
+}
+
+// sendServiceError 에러를 종류에 맞는 상태 코드와 에러 코드로 변환해 요청 언어로 전송
+// 도메인 에러가 아니거나 인프라 장애인 경우 내부 상세는 로그에만 남김
+func (h *UserHandler) sendServiceError(w http.ResponseWriter, r *http.Request, err error) {
+	var validationErr *services.ValidationError
+	if errors.As(err, &validationErr) {
+		h.sendValidationError(w, r, validationErr.Fields...)
+		return
+	}
+
+	loc := h.localizer(r)
+	appErr := apperror.As(err)
+	if appErr == nil || appErr.Kind == apperror.Internal {
+		log.Printf("Internal error: %v", err)
+		h.sendErrorResponse(w, loc, http.StatusInternalServerError, ErrorResponse{
+			Error: loc.Text("error.internal_error", "internal server error", nil),
+			Code:  "internal_error",
+		})
+		return
+	}
+
+	resp := ErrorResponse{
+		Error: loc.Text("error."+appErr.Code, appErr.Message, nil),
+		Code:  appErr.Code,
+	}
+	if appErr.Kind == apperror.Unavailable {
+		log.Printf("Dependency unavailable: %v", err)
+	} else if detail := err.Error(); detail != appErr.Message {
+		resp.Detail = detail // 센티넬에 덧붙인 문맥 (예: 허용되지 않는 상태 전환 내용)
+	}
+	var retryErr *services.RetryError
+	if errors.As(err, &retryErr) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryErr.After.Seconds()))))
+	}
+	h.sendErrorResponse(w, loc, kindStatus[appErr.Kind], resp)
+}
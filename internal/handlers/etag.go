@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+// preconditionFromRequest If-Match 헤더를 프로필 버전 조건으로 변환
+// 형식이 맞지 않는 ETag는 어떤 버전과도 일치하지 않는 것으로 처리
+func preconditionFromRequest(r *http.Request) models.Precondition {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return models.Precondition{}
+	}
+
+	pre := models.Precondition{Present: true}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		// If-Match는 강한 비교를 사용하므로 약한 ETag(W/)는 일치하지 않음
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+		version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+		if err != nil {
+			continue
+		}
+		pre.Versions = append(pre.Versions, version)
+	}
+	return pre
+}
+
+// writeProfile 조회 범위에 맞는 ETag, 캐시 헤더와 함께 프로필 응답 전송
+// 본인, 관리자 조회는 If-Match에 쓰는 버전 ETag를 주고 공유 캐시에 남지 않도록 private로 표시
+// 공개 조회는 수정 횟수가 드러나지 않도록 응답 본문 해시로 만든 약한 ETag 사용
+func writeProfile(w http.ResponseWriter, profile *models.ProfileResponse) {
+	body, err := json.Marshal(profile)
+	if err != nil {
+		log.Printf("Failed to encode profile %s: %v", profile.ID.Hex(), err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	body = append(body, '\n')
+
+	header := w.Header()
+	header.Set("Content-Type", "application/json")
+	header.Add("Vary", "Authorization") // 같은 URL이라도 호출자에 따라 본문이 다름
+	if profile.View == models.ViewPublic {
+		sum := sha256.Sum256(body)
+		header.Set("ETag", `W/"`+hex.EncodeToString(sum[:12])+`"`)
+	} else {
+		header.Set("Cache-Control", "private")
+		header.Set("ETag", models.ETag(profile.Version))
+	}
+	w.Write(body)
+}
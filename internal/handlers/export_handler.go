@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+// CreateMyExport 로그인 사용자의 데이터 내보내기 요청 (비동기 생성, 202 응답)
+func (h *UserHandler) CreateMyExport(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	// 형식은 body 또는 ?format= 으로 지정 (body 생략 가능, 길이를 알 수 없는 chunked 빈 body 포함)
+	var req models.CreateExportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.sendServiceError(w, r, errInvalidBody)
+		return
+	}
+	if req.Format == "" {
+		req.Format = r.URL.Query().Get("format")
+	}
+
+	job, err := h.userService.RequestExport(r.Context(), authID, req.Format)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", exportURL(job))
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(newExportJobResponse(job))
+}
+
+// GetMyExport 데이터 내보내기 작업 상태 조회
+func (h *UserHandler) GetMyExport(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	exportID, err := primitive.ObjectIDFromHex(mux.Vars(r)["exportId"])
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidExportID)
+		return
+	}
+
+	job, err := h.userService.GetExport(r.Context(), authID, exportID)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(newExportJobResponse(job))
+}
+
+// DownloadMyExport 완료된 데이터 내보내기 파일 다운로드
+func (h *UserHandler) DownloadMyExport(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	exportID, err := primitive.ObjectIDFromHex(mux.Vars(r)["exportId"])
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidExportID)
+		return
+	}
+
+	job, err := h.userService.DownloadExport(r.Context(), authID, exportID)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", job.ContentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+job.FileName+`"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(job.Data)))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(job.Data)
+}
+
+func newExportJobResponse(job *models.ExportJob) *models.ExportJobResponse {
+	response := &models.ExportJobResponse{ExportJob: job}
+	if job.Status == models.ExportCompleted {
+		response.DownloadURL = exportURL(job) + "/download"
+	}
+	return response
+}
+
+func exportURL(job *models.ExportJob) string {
+	return "/api/v1/users/me/exports/" + job.ID.Hex()
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// GetMyProfile 로그인 사용자 본인 프로필 조회
+func (h *UserHandler) GetMyProfile(w http.ResponseWriter, r *http.Request) {
+	claims, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	profile, err := h.userService.GetProfileByAuthID(r.Context(), authID, viewerFromClaims(claims))
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	writeProfile(w, profile)
+}
+
+// UpdateMyProfile 로그인 사용자 본인 프로필 업데이트
+func (h *UserHandler) UpdateMyProfile(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendServiceError(w, r, errInvalidBody)
+		return
+	}
+
+	if err := h.userService.UpdateProfileByAuthID(r.Context(), authID, &req, preconditionFromRequest(r)); err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	h.sendMessage(w, r, http.StatusOK, "profile_updated")
+}
+
+// DeleteMyProfile 로그인 사용자 본인 프로필 삭제 (soft delete)
+func (h *UserHandler) DeleteMyProfile(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.DeleteProfileByAuthID(r.Context(), authID, preconditionFromRequest(r)); err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	h.sendMessage(w, r, http.StatusOK, "profile_deleted")
+}
+
+// RestoreMyProfile 로그인 사용자의 탈퇴 프로필 복구 (유예 기간 내)
+func (h *UserHandler) RestoreMyProfile(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.RestoreProfileByAuthID(r.Context(), authID); err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	h.sendMessage(w, r, http.StatusOK, "profile_restored")
+}
+
+// authFromRequest 컨텍스트의 JWT claims와 Auth ID 추출 (실패 시 에러 응답 후 false)
+func (h *UserHandler) authFromRequest(w http.ResponseWriter, r *http.Request) (*utils.JWTClaim, primitive.ObjectID, bool) {
+	claims, err := utils.GetUserFromContext(r.Context())
+	if err != nil {
+		h.sendServiceError(w, r, unauthorized(err))
+		return nil, primitive.NilObjectID, false
+	}
+
+	authID, err := primitive.ObjectIDFromHex(claims.UserID)
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidAuthID)
+		return nil, primitive.NilObjectID, false
+	}
+
+	return claims, authID, true
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/services"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// maxPatchBodySize 패치 요청 본문 최대 크기
+const maxPatchBodySize = 64 << 10
+
+// PatchProfile 프로필 부분 수정
+// application/merge-patch+json, application/json-patch+json을 지원하며
+// application/json은 기존 UpdateProfileRequest 형식으로 처리
+func (h *UserHandler) PatchProfile(w http.ResponseWriter, r *http.Request) {
+	if !isPatchRequest(r) {
+		h.UpdateProfile(w, r)
+		return
+	}
+
+	claims, err := utils.GetUserFromContext(r.Context())
+	if err != nil {
+		h.sendServiceError(w, r, unauthorized(err))
+		return
+	}
+
+	userID, err := primitive.ObjectIDFromHex(mux.Vars(r)["id"])
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidUserID)
+		return
+	}
+
+	patch, ok := h.readPatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.PatchProfile(r.Context(), userID, viewerFromClaims(claims), patch, preconditionFromRequest(r)); err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	h.sendMessage(w, r, http.StatusOK, "profile_updated")
+}
+
+// PatchMyProfile 로그인 사용자 본인 프로필 부분 수정 (형식은 PatchProfile과 동일)
+func (h *UserHandler) PatchMyProfile(w http.ResponseWriter, r *http.Request) {
+	if !isPatchRequest(r) {
+		h.UpdateMyProfile(w, r)
+		return
+	}
+
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	patch, ok := h.readPatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.PatchProfileByAuthID(r.Context(), authID, patch, preconditionFromRequest(r)); err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	h.sendMessage(w, r, http.StatusOK, "profile_updated")
+}
+
+// isPatchRequest 요청 본문이 패치 문서 형식인지 확인
+// application/json 또는 Content-Type이 없으면 false
+func isPatchRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return r.Header.Get("Content-Type") != ""
+	}
+	return mediaType != "application/json"
+}
+
+// readPatch 패치 요청 본문 읽기 (실패 시 에러 응답 후 false)
+func (h *UserHandler) readPatch(w http.ResponseWriter, r *http.Request) (*models.ProfilePatch, bool) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || (mediaType != utils.MergePatchContentType && mediaType != utils.JSONPatchContentType) {
+		w.Header().Set("Accept-Patch", utils.MergePatchContentType+", "+utils.JSONPatchContentType)
+		h.sendServiceError(w, r, services.ErrUnsupportedPatch)
+		return nil, false
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPatchBodySize))
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidBody)
+		return nil, false
+	}
+
+	return &models.ProfilePatch{ContentType: mediaType, Body: body}, true
+}
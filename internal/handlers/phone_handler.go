@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+// RequestPhoneVerification 로그인 사용자의 전화번호로 인증번호 발송 (202 응답)
+func (h *UserHandler) RequestPhoneVerification(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.userService.RequestPhoneVerification(r.Context(), authID)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(result)
+}
+
+// ConfirmPhoneVerification 인증번호 확인 후 전화번호 인증 완료 처리
+func (h *UserHandler) ConfirmPhoneVerification(w http.ResponseWriter, r *http.Request) {
+	_, authID, ok := h.authFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.ConfirmPhoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendServiceError(w, r, errInvalidBody)
+		return
+	}
+
+	if err := h.userService.ConfirmPhoneVerification(r.Context(), authID, &req); err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
+	h.sendMessage(w, r, http.StatusOK, "phone_verified")
+}
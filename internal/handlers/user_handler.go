@@ -2,12 +2,14 @@ package handlers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/gorilla/mux"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/i18n"
 	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
 	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/services"
 	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
@@ -16,50 +18,42 @@ import (
 type UserHandler struct {
 	userService *services.UserService
 	jwtSecret   string
-}
-
-type ErrorResponse struct {
-	Error string `json:"error"`
+	messages    *i18n.Bundle // 응답 메시지 카탈로그 (Accept-Language로 언어 선택)
 }
 
 func NewUserHandler(userService *services.UserService, jwtSecret string) *UserHandler {
 	return &UserHandler{
 		userService: userService,
 		jwtSecret:   jwtSecret,
+		messages:    i18n.DefaultBundle(),
 	}
 }
 
+// WithMessages 응답 메시지 카탈로그 설정
+func (h *UserHandler) WithMessages(messages *i18n.Bundle) *UserHandler {
+	h.messages = messages
+	return h
+}
+
 // CreateProfile 새로운 사용자 프로필 생성
 func (h *UserHandler) CreateProfile(w http.ResponseWriter, r *http.Request) {
-	claims, err := utils.GetUserFromContext(r.Context())
-	if err != nil {
-		h.sendError(w, err.Error(), http.StatusUnauthorized)
-		return
-	}
-
-	// AuthID 파싱
-	authID, err := primitive.ObjectIDFromHex(claims.UserID)
-	if err != nil {
-		h.sendError(w, "Invalid auth ID", http.StatusBadRequest)
+	claims, authID, ok := h.authFromRequest(w, r)
+	if !ok {
 		return
 	}
 
 	var req models.CreateProfileRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.sendError(w, "Invalid request body", http.StatusBadRequest)
+		h.sendServiceError(w, r, errInvalidBody)
 		return
 	}
 
 	if err := h.userService.CreateProfile(r.Context(), authID, claims.Email, &req); err != nil {
-		h.sendError(w, err.Error(), http.StatusBadRequest)
+		h.sendServiceError(w, r, err)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(map[string]string{
-		"message": "Profile created successfully",
-	})
+	h.sendMessage(w, r, http.StatusCreated, "profile_created")
 }
 
 // GetProfile 사용자 프로필 조회
@@ -67,18 +61,17 @@ func (h *UserHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	userID, err := primitive.ObjectIDFromHex(vars["id"])
 	if err != nil {
-		h.sendError(w, "Invalid user ID", http.StatusBadRequest)
+		h.sendServiceError(w, r, errInvalidUserID)
 		return
 	}
 
-	profile, err := h.userService.GetProfile(r.Context(), userID)
+	profile, err := h.userService.GetProfile(r.Context(), userID, viewerFromRequest(r))
 	if err != nil {
-		h.sendError(w, err.Error(), http.StatusNotFound)
+		h.sendServiceError(w, r, err)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(profile)
+	writeProfile(w, profile)
 }
 
 // GetProfileByUsername username으로 프로필 조회
@@ -86,121 +79,214 @@ func (h *UserHandler) GetProfileByUsername(w http.ResponseWriter, r *http.Reques
 	vars := mux.Vars(r)
 	username := vars["username"]
 
-	profile, err := h.userService.GetProfileByUsername(r.Context(), username)
+	profile, err := h.userService.GetProfileByUsername(r.Context(), username, viewerFromRequest(r))
 	if err != nil {
-		h.sendError(w, err.Error(), http.StatusNotFound)
+		h.sendServiceError(w, r, err)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(profile)
+	writeProfile(w, profile)
 }
 
 // UpdateProfile 프로필 업데이트
 func (h *UserHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
 	claims, err := utils.GetUserFromContext(r.Context())
 	if err != nil {
-		h.sendError(w, err.Error(), http.StatusUnauthorized)
+		h.sendServiceError(w, r, unauthorized(err))
 		return
 	}
 
 	vars := mux.Vars(r)
 	userID, err := primitive.ObjectIDFromHex(vars["id"])
 	if err != nil {
-		h.sendError(w, "Invalid user ID", http.StatusBadRequest)
+		h.sendServiceError(w, r, errInvalidUserID)
 		return
 	}
 
-	// 프로필 접근 권한 확인
-	profile, err := h.userService.GetProfile(r.Context(), userID)
-	if err != nil {
-		h.sendError(w, "Profile not found", http.StatusNotFound)
+	var req models.UpdateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.sendServiceError(w, r, errInvalidBody)
 		return
 	}
 
-	if profile.AuthID.Hex() != claims.UserID {
-		h.sendError(w, "Unauthorized to modify this profile", http.StatusForbidden)
+	if err := h.userService.UpdateProfile(r.Context(), userID, viewerFromClaims(claims), &req, preconditionFromRequest(r)); err != nil {
+		h.sendServiceError(w, r, err)
 		return
 	}
 
-	var req models.UpdateProfileRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.sendError(w, "Invalid request body", http.StatusBadRequest)
+	h.sendMessage(w, r, http.StatusOK, "profile_updated")
+}
+
+// DeleteProfile 프로필 삭제 (soft delete)
+func (h *UserHandler) DeleteProfile(w http.ResponseWriter, r *http.Request) {
+	claims, err := utils.GetUserFromContext(r.Context())
+	if err != nil {
+		h.sendServiceError(w, r, unauthorized(err))
+		return
+	}
+
+	vars := mux.Vars(r)
+	userID, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		h.sendServiceError(w, r, errInvalidUserID)
 		return
 	}
 
-	if err := h.userService.UpdateProfile(r.Context(), userID, &req); err != nil {
-		h.sendError(w, err.Error(), http.StatusBadRequest)
+	if err := h.userService.DeleteProfile(r.Context(), userID, viewerFromClaims(claims), preconditionFromRequest(r)); err != nil {
+		h.sendServiceError(w, r, err)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]string{
-		"message": "Profile updated successfully",
-	})
+	h.sendMessage(w, r, http.StatusOK, "profile_deleted")
 }
 
-// DeleteProfile 프로필 삭제 (soft delete)
-func (h *UserHandler) DeleteProfile(w http.ResponseWriter, r *http.Request) {
+// RestoreProfile 탈퇴한 프로필 복구 (유예 기간 내)
+func (h *UserHandler) RestoreProfile(w http.ResponseWriter, r *http.Request) {
 	claims, err := utils.GetUserFromContext(r.Context())
 	if err != nil {
-		h.sendError(w, err.Error(), http.StatusUnauthorized)
+		h.sendServiceError(w, r, unauthorized(err))
 		return
 	}
 
 	vars := mux.Vars(r)
 	userID, err := primitive.ObjectIDFromHex(vars["id"])
 	if err != nil {
-		h.sendError(w, "Invalid user ID", http.StatusBadRequest)
+		h.sendServiceError(w, r, errInvalidUserID)
+		return
+	}
+
+	if err := h.userService.RestoreProfile(r.Context(), userID, viewerFromClaims(claims)); err != nil {
+		h.sendServiceError(w, r, err)
 		return
 	}
 
-	// 프로필 접근 권한 확인
-	profile, err := h.userService.GetProfile(r.Context(), userID)
+	h.sendMessage(w, r, http.StatusOK, "profile_restored")
+}
+
+// SearchProfiles 사용자 검색
+// 쿼리: q, city, country, status(관리자), limit, cursor(이전 응답의 next_cursor)
+func (h *UserHandler) SearchProfiles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	req := models.SearchRequest{
+		Query:   strings.TrimSpace(query.Get("q")),
+		City:    query.Get("city"),
+		Country: query.Get("country"),
+		Status:  query.Get("status"),
+		Cursor:  query.Get("cursor"),
+	}
+	if req.Query == "" {
+		h.sendValidationError(w, r, services.FieldError{Field: "q", Code: utils.ViolationRequired, Message: "Search query is required"})
+		return
+	}
+	var invalid []services.FieldError
+	if req.Limit = parsePositiveParam(query, "limit", &invalid); len(invalid) > 0 {
+		h.sendValidationError(w, r, invalid...)
+		return
+	}
+
+	result, err := h.userService.SearchProfiles(r.Context(), req, viewerFromRequest(r))
 	if err != nil {
-		h.sendError(w, "Profile not found", http.StatusNotFound)
+		h.sendServiceError(w, r, err)
 		return
 	}
 
-	if profile.AuthID.Hex() != claims.UserID && claims.Role != "admin" {
-		h.sendError(w, "Unauthorized to delete this profile", http.StatusForbidden)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// FuzzySearchProfiles 오타 허용 사용자 검색
+// 쿼리: q, limit (기본 20, 최대 50)
+func (h *UserHandler) FuzzySearchProfiles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var invalid []services.FieldError
+	limit := parsePositiveParam(query, "limit", &invalid)
+	if len(invalid) > 0 {
+		h.sendValidationError(w, r, invalid...)
 		return
 	}
 
-	if err := h.userService.DeleteProfile(r.Context(), userID); err != nil {
-		h.sendError(w, err.Error(), http.StatusInternalServerError)
+	result, err := h.userService.FuzzySearchProfiles(r.Context(), query.Get("q"), limit)
+	if err != nil {
+		h.sendServiceError(w, r, err)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]string{
-		"message": "Profile deleted successfully",
-	})
+	json.NewEncoder(w).Encode(result)
 }
 
-// SearchProfiles 사용자 검색
-func (h *UserHandler) SearchProfiles(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query().Get("q")
-	query = strings.TrimSpace(query)
+// NearbyProfiles 주변 판매자 검색 (위치 공개한 active 프로필, 가까운 순)
+// 쿼리: lat, lng, radius_km (기본 10, 최대 100), limit (기본 20, 최대 100)
+func (h *UserHandler) NearbyProfiles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
 
-	if query == "" {
-		h.sendError(w, "Search query is required", http.StatusBadRequest)
+	var invalid []services.FieldError
+	req := models.NearbyRequest{
+		Lat:   parseFloatParam(query, "lat", &invalid),
+		Lng:   parseFloatParam(query, "lng", &invalid),
+		Limit: parsePositiveParam(query, "limit", &invalid),
+	}
+	if radius := parseFloatParam(query, "radius_km", &invalid); radius != nil {
+		if *radius <= 0 {
+			invalid = append(invalid, services.FieldError{
+				Field:   "radius_km",
+				Code:    utils.ViolationInvalidFormat,
+				Message: fmt.Sprintf("invalid radius_km: %s", query.Get("radius_km")),
+			})
+		}
+		req.RadiusKm = *radius
+	}
+	if len(invalid) > 0 {
+		h.sendValidationError(w, r, invalid...)
 		return
 	}
 
-	profiles, err := h.userService.SearchProfiles(r.Context(), query)
+	result, err := h.userService.NearbyProfiles(r.Context(), req, viewerFromRequest(r))
 	if err != nil {
-		h.sendError(w, err.Error(), http.StatusBadRequest)
+		h.sendServiceError(w, r, err)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(profiles)
+	json.NewEncoder(w).Encode(result)
 }
 
-// sendError 에러 응답 전송 헬퍼 함수
-func (h *UserHandler) sendError(w http.ResponseWriter, message string, status int) {
+// AutocompleteProfiles username/이름 접두어 자동완성
+// 쿼리: q, limit (기본 8, 최대 20)
+func (h *UserHandler) AutocompleteProfiles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var invalid []services.FieldError
+	limit := parsePositiveParam(query, "limit", &invalid)
+	if len(invalid) > 0 {
+		h.sendValidationError(w, r, invalid...)
+		return
+	}
+
+	result, err := h.userService.AutocompleteProfiles(r.Context(), query.Get("q"), limit)
+	if err != nil {
+		h.sendServiceError(w, r, err)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	json.NewEncoder(w).Encode(ErrorResponse{Error: message})
+	json.NewEncoder(w).Encode(result)
+}
+
+// viewerFromRequest 요청 컨텍스트의 JWT claims로 조회자 결정 (없으면 비로그인)
+func viewerFromRequest(r *http.Request) models.Viewer {
+	claims, err := utils.GetUserFromContext(r.Context())
+	if err != nil {
+		return models.Viewer{}
+	}
+	return viewerFromClaims(claims)
+}
+
+func viewerFromClaims(claims *utils.JWTClaim) models.Viewer {
+	return models.Viewer{
+		AuthID: claims.UserID,
+		Role:   claims.Role,
+	}
 }
@@ -0,0 +1,364 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository/memory"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/services"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/middleware"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+const testSecret = "test-secret"
+
+// testServer 메모리 저장소로 구성한 서비스와 cmd/main.go와 같은 경로의 라우터
+type testServer struct {
+	t      *testing.T
+	router *mux.Router
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	userService := services.NewUserService(memory.NewUserRepository())
+	h := NewUserHandler(userService, testSecret)
+	auth := middleware.NewJWTMiddleware(testSecret)
+
+	r := mux.NewRouter()
+	public := r.PathPrefix("/api/v1/public").Subrouter()
+	public.Use(auth.OptionalJWT)
+	public.HandleFunc("/users/search", h.SearchProfiles).Methods("GET")
+	public.HandleFunc("/users/username/{username}", h.GetProfileByUsername).Methods("GET")
+	public.HandleFunc("/users/{id}", h.GetProfile).Methods("GET")
+
+	protected := r.PathPrefix("/api/v1").Subrouter()
+	protected.Use(auth.ValidateJWT)
+	protected.HandleFunc("/users", h.CreateProfile).Methods("POST")
+	protected.HandleFunc("/users/me", h.GetMyProfile).Methods("GET")
+	protected.HandleFunc("/users/me", h.UpdateMyProfile).Methods("PUT")
+	protected.HandleFunc("/users/me", h.PatchMyProfile).Methods("PATCH")
+
+	admin := r.PathPrefix("/api/v1/admin").Subrouter()
+	admin.Use(auth.ValidateJWT)
+	admin.Use(auth.RequireRole("admin"))
+	admin.HandleFunc("/users/{id}", h.AdminGetProfile).Methods("GET")
+
+	return &testServer{t: t, router: r}
+}
+
+// testUser 테스트용 Auth Service 사용자
+type testUser struct {
+	id    string
+	email string
+	role  string
+}
+
+func newTestUser(role string) testUser {
+	id := primitive.NewObjectID().Hex()
+	return testUser{id: id, email: id + "@example.com", role: role}
+}
+
+func (u testUser) token(t *testing.T) string {
+	t.Helper()
+	token, err := utils.GenerateJWT(u.id, u.email, u.role, testSecret, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// request user가 nil이 아니면 Authorization 헤더를 붙이고, headers는 이름, 값 순서
+func (s *testServer) request(method, path string, user *testUser, body string, headers ...string) *httptest.ResponseRecorder {
+	s.t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if user != nil {
+		req.Header.Set("Authorization", "Bearer "+user.token(s.t))
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func profileBody(username, phone string) string {
+	body, _ := json.Marshal(map[string]interface{}{
+		"username":     username,
+		"first_name":   "Kihyun",
+		"last_name":    "Kim",
+		"phone_number": phone,
+		"address": map[string]string{
+			"country": "KR", "city": "Seoul", "street": "1 Sejong-daero", "postal_code": "04524",
+		},
+	})
+	return string(body)
+}
+
+// createProfile 프로필을 만들고 ID 반환
+func (s *testServer) createProfile(user testUser, username, phone string) string {
+	s.t.Helper()
+	if rec := s.request("POST", "/api/v1/users", &user, profileBody(username, phone)); rec.Code != http.StatusCreated {
+		s.t.Fatalf("create %s: %d %s", username, rec.Code, rec.Body)
+	}
+	rec := s.request("GET", "/api/v1/users/me", &user, "")
+	var profile map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &profile); err != nil {
+		s.t.Fatalf("decode profile: %v (%s)", err, rec.Body)
+	}
+	return profile["id"].(string)
+}
+
+func decodeBody(t *testing.T, rec *httptest.ResponseRecorder) map[string]interface{} {
+	t.Helper()
+	var body map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode response: %v (%s)", err, rec.Body)
+	}
+	return body
+}
+
+func TestCreateProfile(t *testing.T) {
+	owner := newTestUser("user")
+
+	tests := []struct {
+		name       string
+		user       *testUser
+		body       string
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "no token", user: nil, body: profileBody("polaris", "+821099998888"), wantStatus: http.StatusUnauthorized, wantCode: "unauthorized"},
+		{name: "malformed body", user: &testUser{}, body: `{"username":`, wantStatus: http.StatusBadRequest, wantCode: "invalid_request_body"},
+		{name: "missing phone number", user: &testUser{}, body: profileBody("polaris", ""), wantStatus: http.StatusBadRequest, wantCode: "validation_failed"},
+		{name: "profile already exists", user: &owner, body: profileBody("polaris", "+821099998888"), wantStatus: http.StatusConflict, wantCode: "profile_exists"},
+		{name: "same username", user: &testUser{}, body: profileBody("kihyun", "+821099998888"), wantStatus: http.StatusConflict, wantCode: "username_taken"},
+		{name: "username in another case", user: &testUser{}, body: profileBody("KiHyun", "+821099998888"), wantStatus: http.StatusConflict, wantCode: "username_taken"},
+		{name: "username with lookalike characters", user: &testUser{}, body: profileBody("k1hyun", "+821099998888"), wantStatus: http.StatusConflict, wantCode: "username_taken"},
+		{name: "same phone number in national format", user: &testUser{}, body: profileBody("polaris", "010-1234-5678"), wantStatus: http.StatusConflict, wantCode: "phone_number_taken"},
+		{name: "new profile", user: &testUser{}, body: profileBody("polaris", "010-9999-8888"), wantStatus: http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			s.createProfile(owner, "kihyun", "+821012345678")
+
+			user := tt.user
+			if user != nil && user.id == "" {
+				fresh := newTestUser("user")
+				user = &fresh
+			}
+			rec := s.request("POST", "/api/v1/users", user, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantCode != "" {
+				if code := decodeBody(t, rec)["code"]; code != tt.wantCode {
+					t.Errorf("code = %v, want %s", code, tt.wantCode)
+				}
+			}
+		})
+	}
+}
+
+func TestGetProfileViews(t *testing.T) {
+	s := newTestServer(t)
+	owner := newTestUser("user")
+	other := newTestUser("user")
+	admin := newTestUser("admin")
+	id := s.createProfile(owner, "kihyun", "+821012345678")
+
+	tests := []struct {
+		name        string
+		path        string
+		user        *testUser
+		wantStatus  int
+		wantCode    string
+		wantPrivate bool // 본인, 관리자 조회 (버전 ETag, Cache-Control: private, 비공개 필드 포함)
+	}{
+		{name: "anonymous", path: "/api/v1/public/users/" + id, wantStatus: http.StatusOK},
+		{name: "another user", path: "/api/v1/public/users/" + id, user: &other, wantStatus: http.StatusOK},
+		{name: "owner", path: "/api/v1/public/users/" + id, user: &owner, wantStatus: http.StatusOK, wantPrivate: true},
+		{name: "by username", path: "/api/v1/public/users/username/kihyun", wantStatus: http.StatusOK},
+		{name: "me", path: "/api/v1/users/me", user: &owner, wantStatus: http.StatusOK, wantPrivate: true},
+		{name: "admin", path: "/api/v1/admin/users/" + id, user: &admin, wantStatus: http.StatusOK, wantPrivate: true},
+		{name: "admin route as user", path: "/api/v1/admin/users/" + id, user: &owner, wantStatus: http.StatusForbidden, wantCode: "forbidden"},
+		{name: "me without profile", path: "/api/v1/users/me", user: &other, wantStatus: http.StatusNotFound, wantCode: "profile_not_created"},
+		{name: "invalid id", path: "/api/v1/public/users/not-an-id", wantStatus: http.StatusBadRequest, wantCode: "invalid_user_id"},
+		{name: "unknown id", path: "/api/v1/public/users/" + primitive.NewObjectID().Hex(), wantStatus: http.StatusNotFound, wantCode: "profile_not_found"},
+		{name: "unknown username", path: "/api/v1/public/users/username/nobody", wantStatus: http.StatusNotFound, wantCode: "profile_not_found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.request("GET", tt.path, tt.user, "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", rec.Code, tt.wantStatus, rec.Body)
+			}
+			body := decodeBody(t, rec)
+			if tt.wantCode != "" {
+				if body["code"] != tt.wantCode {
+					t.Errorf("code = %v, want %s", body["code"], tt.wantCode)
+				}
+				return
+			}
+
+			etag, cacheControl := rec.Header().Get("ETag"), rec.Header().Get("Cache-Control")
+			_, hasPhone := body["phone_number"]
+			_, hasPrivacy := body["privacy"]
+			if tt.wantPrivate {
+				if etag != `"1"` || cacheControl != "private" {
+					t.Errorf("ETag = %s, Cache-Control = %q, want version ETag and private", etag, cacheControl)
+				}
+				if !hasPhone || !hasPrivacy {
+					t.Errorf("private fields missing: %s", rec.Body)
+				}
+			} else {
+				if !strings.HasPrefix(etag, `W/"`) || etag == `W/"1"` || cacheControl != "" {
+					t.Errorf("ETag = %s, Cache-Control = %q, want opaque weak ETag", etag, cacheControl)
+				}
+				if hasPhone || hasPrivacy || body["email"] != nil {
+					t.Errorf("private fields exposed: %s", rec.Body)
+				}
+				if body["first_name"] != "Kihyun" {
+					t.Errorf("public name missing: %s", rec.Body)
+				}
+			}
+			if vary := rec.Header().Get("Vary"); vary != "Authorization" {
+				t.Errorf("Vary = %q, want Authorization", vary)
+			}
+		})
+	}
+}
+
+func TestUpdateMyProfile(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		ifMatch     string
+		body        string
+		wantStatus  int
+		wantCode    string
+		wantFirst   string
+	}{
+		{name: "put", method: "PUT", body: `{"first_name":"Ki"}`, wantStatus: http.StatusOK, wantFirst: "Ki"},
+		{name: "put with current version", method: "PUT", ifMatch: `"1"`, body: `{"first_name":"Ki"}`, wantStatus: http.StatusOK, wantFirst: "Ki"},
+		{name: "put with stale version", method: "PUT", ifMatch: `"0"`, body: `{"first_name":"Ki"}`, wantStatus: http.StatusPreconditionFailed, wantCode: "precondition_failed"},
+		{name: "put with weak etag", method: "PUT", ifMatch: `W/"1"`, body: `{"first_name":"Ki"}`, wantStatus: http.StatusPreconditionFailed, wantCode: "precondition_failed"},
+		{name: "put with invalid name", method: "PUT", body: `{"first_name":"Ki1"}`, wantStatus: http.StatusBadRequest, wantCode: "validation_failed"},
+		{name: "merge patch", method: "PATCH", contentType: utils.MergePatchContentType, body: `{"first_name":"Ki"}`, wantStatus: http.StatusOK, wantFirst: "Ki"},
+		{name: "json patch", method: "PATCH", contentType: utils.JSONPatchContentType, body: `[{"op":"test","path":"/first_name","value":"Kihyun"},{"op":"replace","path":"/first_name","value":"Ki"}]`, wantStatus: http.StatusOK, wantFirst: "Ki"},
+		{name: "json patch with failing test", method: "PATCH", contentType: utils.JSONPatchContentType, body: `[{"op":"test","path":"/first_name","value":"Someone"},{"op":"replace","path":"/first_name","value":"Ki"}]`, wantStatus: http.StatusBadRequest, wantCode: "invalid_patch"},
+		{name: "unsupported patch type", method: "PATCH", contentType: "application/xml", body: `<first_name>Ki</first_name>`, wantStatus: http.StatusUnsupportedMediaType, wantCode: "unsupported_patch"},
+		{name: "rename to a lookalike of another user", method: "PUT", body: `{"username":"P0LARIS"}`, wantStatus: http.StatusConflict, wantCode: "username_taken"},
+		{name: "rename to own username in another case", method: "PUT", body: `{"username":"KiHyun"}`, wantStatus: http.StatusOK, wantFirst: "Kihyun"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			owner := newTestUser("user")
+			s.createProfile(owner, "kihyun", "+821012345678")
+			s.createProfile(newTestUser("user"), "polaris", "+821099998888")
+
+			var headers []string
+			if tt.contentType != "" {
+				headers = append(headers, "Content-Type", tt.contentType)
+			}
+			if tt.ifMatch != "" {
+				headers = append(headers, "If-Match", tt.ifMatch)
+			}
+			rec := s.request(tt.method, "/api/v1/users/me", &owner, tt.body, headers...)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantCode != "" {
+				if code := decodeBody(t, rec)["code"]; code != tt.wantCode {
+					t.Errorf("code = %v, want %s", code, tt.wantCode)
+				}
+			}
+
+			me := s.request("GET", "/api/v1/users/me", &owner, "")
+			body := decodeBody(t, me)
+			wantFirst, wantETag := tt.wantFirst, `"2"`
+			if tt.wantCode != "" {
+				wantFirst, wantETag = "Kihyun", `"1"`
+			}
+			if body["first_name"] != wantFirst || me.Header().Get("ETag") != wantETag {
+				t.Errorf("after update: first_name = %v, ETag = %s, want %s, %s", body["first_name"], me.Header().Get("ETag"), wantFirst, wantETag)
+			}
+		})
+	}
+}
+
+func TestSearchProfiles(t *testing.T) {
+	s := newTestServer(t)
+	owner := newTestUser("user")
+	s.createProfile(owner, "kihyun", "+821012345678")
+	s.createProfile(newTestUser("user"), "polaris", "+821099998888")
+	hidden := newTestUser("user")
+	s.createProfile(hidden, "jdoe", "+821055556666")
+	if rec := s.request("PATCH", "/api/v1/users/me", &hidden, `{"first_name":"Jane","privacy":{"show_name":false}}`,
+		"Content-Type", utils.MergePatchContentType); rec.Code != http.StatusOK {
+		t.Fatalf("hide name: %d %s", rec.Code, rec.Body)
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		user       *testUser
+		wantStatus int
+		want       []string
+	}{
+		{name: "by public name", query: "q=kim", wantStatus: http.StatusOK, want: []string{"kihyun", "polaris"}},
+		{name: "by username", query: "q=polaris", wantStatus: http.StatusOK, want: []string{"polaris"}},
+		{name: "private name hidden", query: "q=jane", wantStatus: http.StatusOK, want: []string{}},
+		{name: "private name visible to owner", query: "q=jane", user: &hidden, wantStatus: http.StatusOK, want: []string{"jdoe"}},
+		{name: "missing query", query: "", wantStatus: http.StatusBadRequest},
+		{name: "invalid limit", query: "q=kim&limit=0", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.request("GET", "/api/v1/public/users/search?"+tt.query, tt.user, "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.want == nil {
+				return
+			}
+
+			var result struct {
+				Items []struct {
+					Username string `json:"username"`
+				} `json:"items"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+				t.Fatal(err)
+			}
+			got := map[string]bool{}
+			for _, item := range result.Items {
+				got[item.Username] = true
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for _, username := range tt.want {
+				if !got[username] {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+//go:embed locales/*.json
+var embedded embed.FS
+
+// DefaultLanguage 요청 언어와 맞는 카탈로그가 없을 때 사용하는 언어
+const DefaultLanguage = "en"
+
+// Catalog 메시지 키와 메시지 템플릿 ("{name}" 자리에 파라미터 값이 들어감)
+// 키 형식: error.<에러 코드>, violation.<위반 코드>[.<필드>], field.<필드 경로>, message.<응답 메시지>
+type Catalog map[string]string
+
+// Bundle 언어별 메시지 카탈로그 모음
+type Bundle struct {
+	fallback language.Tag
+	tags     []language.Tag // matcher가 반환하는 인덱스 순서 (fallback이 첫 번째)
+	catalogs map[language.Tag]Catalog
+	matcher  language.Matcher
+}
+
+// LoadBundle 내장 카탈로그를 읽은 뒤 dir의 <언어 태그>.json 파일로 언어를 추가하거나 메시지를 덮어씀
+// dir이 비어 있으면 내장 카탈로그만 사용
+func LoadBundle(dir, fallback string) (*Bundle, error) {
+	fallbackTag, err := language.Parse(fallback)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default language %q: %w", fallback, err)
+	}
+
+	catalogs := map[language.Tag]Catalog{}
+	if err := loadCatalogs(catalogs, embedded, "locales"); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := loadCatalogs(catalogs, os.DirFS(dir), "."); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := catalogs[fallbackTag]; !ok {
+		return nil, fmt.Errorf("no catalog for default language %q", fallback)
+	}
+
+	tags := []language.Tag{fallbackTag}
+	for tag := range catalogs {
+		if tag != fallbackTag {
+			tags = append(tags, tag)
+		}
+	}
+
+	return &Bundle{
+		fallback: fallbackTag,
+		tags:     tags,
+		catalogs: catalogs,
+		matcher:  language.NewMatcher(tags),
+	}, nil
+}
+
+// DefaultBundle 내장 카탈로그만 사용하는 번들
+func DefaultBundle() *Bundle {
+	bundle, err := LoadBundle("", DefaultLanguage)
+	if err != nil {
+		panic(err) // 내장 카탈로그 오류는 빌드 결함
+	}
+	return bundle
+}
+
+func loadCatalogs(catalogs map[language.Tag]Catalog, fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		tag, err := language.Parse(strings.TrimSuffix(entry.Name(), ".json"))
+		if err != nil {
+			return fmt.Errorf("catalog %s: invalid language tag: %w", entry.Name(), err)
+		}
+
+		data, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		var catalog Catalog
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return fmt.Errorf("catalog %s: %w", entry.Name(), err)
+		}
+
+		if catalogs[tag] == nil {
+			catalogs[tag] = Catalog{}
+		}
+		for key, message := range catalog {
+			catalogs[tag][key] = message
+		}
+	}
+	return nil
+}
+
+// Localizer 한 요청의 언어에 맞춘 메시지 조회기
+type Localizer struct {
+	tag   language.Tag
+	chain []Catalog // 조회 순서 (요청 언어, 기본 언어)
+}
+
+// Localizer Accept-Language 헤더 값으로 사용할 언어 결정
+// 지원하는 언어가 없거나 헤더가 잘못된 경우 기본 언어 사용
+func (b *Bundle) Localizer(acceptLanguage string) *Localizer {
+	tag := b.fallback
+	if requested, _, err := language.ParseAcceptLanguage(acceptLanguage); err == nil && len(requested) > 0 {
+		if _, index, confidence := b.matcher.Match(requested...); confidence != language.No {
+			tag = b.tags[index]
+		}
+	}
+
+	chain := []Catalog{b.catalogs[tag]}
+	if tag != b.fallback {
+		chain = append(chain, b.catalogs[b.fallback])
+	}
+	return &Localizer{tag: tag, chain: chain}
+}
+
+// Language 선택된 언어 태그 (Content-Language 헤더 값)
+func (l *Localizer) Language() string {
+	return l.tag.String()
+}
+
+// Message key의 메시지를 params로 채워 반환 (요청 언어에 없으면 기본 언어, 둘 다 없으면 false)
+func (l *Localizer) Message(key string, params map[string]string) (string, bool) {
+	for _, catalog := range l.chain {
+		if message, ok := catalog[key]; ok {
+			return format(message, params), true
+		}
+	}
+	return "", false
+}
+
+// Text key의 메시지 반환 (어느 카탈로그에도 없으면 def 반환)
+func (l *Localizer) Text(key, def string, params map[string]string) string {
+	if message, ok := l.Message(key, params); ok {
+		return message
+	}
+	return def
+}
+
+func format(message string, params map[string]string) string {
+	if len(params) == 0 {
+		return message
+	}
+	pairs := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(message)
+}
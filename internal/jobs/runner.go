@@ -0,0 +1,43 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Job 일정 주기로 실행되는 백그라운드 작업
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Start 작업을 고루틴에서 주기적으로 실행 (ctx가 취소되면 종료)
+// 한 번의 실행이 다음 주기보다 길어지면 겹쳐 실행하지 않고 건너뜀
+func Start(ctx context.Context, job Job) {
+	if job.Interval <= 0 {
+		log.Printf("Job %s disabled (interval %v)", job.Name, job.Interval)
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(job.Interval)
+		defer ticker.Stop()
+
+		log.Printf("Job %s started (every %v)", job.Name, job.Interval)
+		for {
+			select {
+			case <-ctx.Done():
+				log.Printf("Job %s stopped", job.Name)
+				return
+			case <-ticker.C:
+				runCtx, cancel := context.WithTimeout(ctx, job.Interval)
+				if err := job.Run(runCtx); err != nil {
+					log.Printf("Job %s failed: %v", job.Name, err)
+				}
+				cancel()
+			}
+		}
+	}()
+}
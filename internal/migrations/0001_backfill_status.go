@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// status 필드가 없는 기존 프로필을 active로 채움
+// 원래 값이 없던 문서를 구분할 수 없으므로 되돌릴 수 없음
+func init() {
+	register(Migration{
+		Version:     1,
+		Description: "backfill missing profile status",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("users").UpdateMany(ctx,
+				bson.M{"status": bson.M{"$exists": false}},
+				bson.M{"$set": bson.M{"status": "active"}},
+			)
+			return err
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+// 공개 설정이 없는 기존 프로필에 기본 공개 설정 적용
+func init() {
+	register(Migration{
+		Version:     2,
+		Description: "backfill default privacy settings",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("users").UpdateMany(ctx,
+				bson.M{"privacy": bson.M{"$exists": false}},
+				bson.M{"$set": bson.M{"privacy": models.DefaultPrivacySettings()}},
+			)
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("users").UpdateMany(ctx,
+				bson.M{"privacy": bson.M{"$exists": true}},
+				bson.M{"$unset": bson.M{"privacy": ""}},
+			)
+			return err
+		},
+	})
+}
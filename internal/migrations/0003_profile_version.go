@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// 낙관적 동시성 제어용 version 필드가 없는 기존 프로필을 버전 1로 초기화
+func init() {
+	register(Migration{
+		Version:     3,
+		Description: "backfill profile version",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("users").UpdateMany(ctx,
+				bson.M{"version": bson.M{"$exists": false}},
+				bson.M{"$set": bson.M{"version": int64(1)}},
+			)
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("users").UpdateMany(ctx,
+				bson.M{"version": bson.M{"$exists": true}},
+				bson.M{"$unset": bson.M{"version": ""}},
+			)
+			return err
+		},
+	})
+}
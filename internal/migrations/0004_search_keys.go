@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+// 기존 프로필에 자동완성 검색 키 생성
+func init() {
+	register(Migration{
+		Version:     4,
+		Description: "backfill autocomplete search keys",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			users := db.Collection("users")
+			cursor, err := users.Find(ctx, bson.M{
+				"search_keys": bson.M{"$exists": false},
+				"status":      bson.M{"$ne": models.StatusErased},
+			})
+			if err != nil {
+				return err
+			}
+			defer cursor.Close(ctx)
+
+			for cursor.Next(ctx) {
+				var profile models.UserProfile
+				if err := cursor.Decode(&profile); err != nil {
+					return err
+				}
+				_, err := users.UpdateOne(ctx,
+					bson.M{"_id": profile.ID},
+					bson.M{"$set": bson.M{"search_keys": models.BuildSearchKeys(&profile)}},
+				)
+				if err != nil {
+					return err
+				}
+			}
+			return cursor.Err()
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("users").UpdateMany(ctx,
+				bson.M{"search_keys": bson.M{"$exists": true}},
+				bson.M{"$unset": bson.M{"search_keys": ""}},
+			)
+			return err
+		},
+	})
+}
@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/address"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/phone"
+)
+
+// 기존 전화번호를 주소 국가 기준으로 E.164 정규화
+// 해석할 수 없는 번호와 먼저 가입한 프로필이 이미 쓰는 번호는 그대로 두고 개수만 기록
+func init() {
+	register(Migration{
+		Version:     5,
+		Description: "normalize phone numbers to E.164",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			users := db.Collection("users")
+			cursor, err := users.Find(ctx, bson.M{
+				"phone_number": bson.M{"$nin": bson.A{"", nil}},
+				"phone_e164":   bson.M{"$exists": false},
+				"status":       bson.M{"$ne": models.StatusErased},
+			}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+			if err != nil {
+				return err
+			}
+			defer cursor.Close(ctx)
+
+			// 유니크 인덱스가 아직 없을 수도 있어 처리한 번호를 직접 추적
+			seen := map[string]bool{}
+			var invalid, duplicates int
+			for cursor.Next(ctx) {
+				var profile models.UserProfile
+				if err := cursor.Decode(&profile); err != nil {
+					return err
+				}
+				region, _ := address.ResolveCountry(profile.Address.Country)
+				number, err := phone.Parse(profile.PhoneNumber, region)
+				if err != nil {
+					invalid++
+					continue
+				}
+				if seen[number.E164] {
+					duplicates++
+					continue
+				}
+				seen[number.E164] = true
+				_, err = users.UpdateOne(ctx,
+					bson.M{"_id": profile.ID},
+					bson.M{"$set": bson.M{"phone_number": number.String(), "phone_e164": number.E164}},
+				)
+				if mongo.IsDuplicateKeyError(err) {
+					duplicates++
+					continue
+				}
+				if err != nil {
+					return err
+				}
+			}
+			if invalid > 0 || duplicates > 0 {
+				log.Printf("Left %d invalid and %d duplicate phone numbers unnormalized", invalid, duplicates)
+			}
+			return cursor.Err()
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("users").UpdateMany(ctx,
+				bson.M{"phone_e164": bson.M{"$exists": true}},
+				bson.M{"$unset": bson.M{"phone_e164": ""}},
+			)
+			return err
+		},
+	})
+}
@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// 기존 프로필에 username 중복 확인용 정규화 키 추가
+// 먼저 가입한 프로필이 이미 쓰는 키는 채우지 않고 개수만 기록 (해당 프로필은 username을 바꿀 때 키가 생김)
+func init() {
+	register(Migration{
+		Version:     6,
+		Description: "add normalized username keys",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			users := db.Collection("users")
+			cursor, err := users.Find(ctx, bson.M{
+				"username_key": bson.M{"$exists": false},
+				"status":       bson.M{"$ne": models.StatusErased},
+			}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+			if err != nil {
+				return err
+			}
+			defer cursor.Close(ctx)
+
+			// 유니크 인덱스가 아직 없을 수도 있어 처리한 키를 직접 추적
+			seen := map[string]bool{}
+			var duplicates int
+			for cursor.Next(ctx) {
+				var profile models.UserProfile
+				if err := cursor.Decode(&profile); err != nil {
+					return err
+				}
+				key := utils.UsernameKey(profile.Username)
+				if seen[key] {
+					duplicates++
+					continue
+				}
+				seen[key] = true
+				_, err = users.UpdateOne(ctx,
+					bson.M{"_id": profile.ID},
+					bson.M{"$set": bson.M{"username_key": key}},
+				)
+				if mongo.IsDuplicateKeyError(err) {
+					duplicates++
+					continue
+				}
+				if err != nil {
+					return err
+				}
+			}
+			if duplicates > 0 {
+				log.Printf("Left %d lookalike usernames without a username key", duplicates)
+			}
+			return cursor.Err()
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("users").UpdateMany(ctx,
+				bson.M{"username_key": bson.M{"$exists": true}},
+				bson.M{"$unset": bson.M{"username_key": ""}},
+			)
+			return err
+		},
+	})
+}
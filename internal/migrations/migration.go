@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Migration 버전이 매겨진 스키마 마이그레이션 단계
+// Up/Down은 여러 번 실행해도 결과가 같도록(idempotent) 작성해야 함
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database) error
+	Down        func(ctx context.Context, db *mongo.Database) error // nil이면 되돌릴 수 없는 마이그레이션
+}
+
+// registry 등록된 마이그레이션 목록 (각 마이그레이션 파일의 init에서 등록)
+var registry []Migration
+
+func register(m Migration) {
+	registry = append(registry, m)
+}
+
+// All 버전 순으로 정렬된 마이그레이션 목록 반환
+func All() ([]Migration, error) {
+	migrations := make([]Migration, len(registry))
+	copy(migrations, registry)
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	for i, m := range migrations {
+		if m.Version <= 0 {
+			return nil, fmt.Errorf("migration %q has invalid version %d", m.Description, m.Version)
+		}
+		if m.Up == nil {
+			return nil, fmt.Errorf("migration %d has no up step", m.Version)
+		}
+		if i > 0 && migrations[i-1].Version == m.Version {
+			return nil, fmt.Errorf("duplicate migration version %d", m.Version)
+		}
+	}
+
+	return migrations, nil
+}
@@ -0,0 +1,265 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	migrationsCollection = "schema_migrations"
+	lockCollection       = "schema_migrations_lock"
+	lockID               = "migrate"
+	lockTTL              = 2 * time.Minute // 비정상 종료된 실행의 잠금을 회수하기까지의 시간 (실행 중에는 계속 연장)
+	lockRenewInterval    = lockTTL / 4
+)
+
+// AppliedMigration schema_migrations 컬렉션에 기록되는 적용 이력
+type AppliedMigration struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+}
+
+// MigrationStatus 마이그레이션별 적용 상태
+type MigrationStatus struct {
+	Version     int
+	Description string
+	Applied     bool
+	AppliedAt   time.Time
+	Reversible  bool
+}
+
+type Migrator struct {
+	db         *mongo.Database
+	migrations []Migration
+	owner      string
+}
+
+func NewMigrator(db *mongo.Database) (*Migrator, error) {
+	migrations, err := All()
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, _ := os.Hostname()
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		owner:      fmt.Sprintf("%s:%d", hostname, os.Getpid()),
+	}, nil
+}
+
+// Up target 버전까지 미적용 마이그레이션 실행 (target이 0이면 전부)
+func (m *Migrator) Up(ctx context.Context, target int) ([]int, error) {
+	var applied []int
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		done, err := m.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if target > 0 && migration.Version > target {
+				break
+			}
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+
+			log.Printf("Applying migration %d: %s", migration.Version, migration.Description)
+			if err := migration.Up(ctx, m.db); err != nil {
+				return fmt.Errorf("migration %d failed: %w", migration.Version, err)
+			}
+
+			record := AppliedMigration{
+				Version:     migration.Version,
+				Description: migration.Description,
+				AppliedAt:   time.Now(),
+			}
+			if _, err := m.db.Collection(migrationsCollection).InsertOne(ctx, record); err != nil {
+				return fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+			}
+			applied = append(applied, migration.Version)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down 가장 최근에 적용된 마이그레이션부터 steps개 되돌림
+func (m *Migrator) Down(ctx context.Context, steps int) ([]int, error) {
+	var reverted []int
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		done, err := m.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+			if migration.Down == nil {
+				return fmt.Errorf("migration %d is irreversible", migration.Version)
+			}
+
+			log.Printf("Reverting migration %d: %s", migration.Version, migration.Description)
+			if err := migration.Down(ctx, m.db); err != nil {
+				return fmt.Errorf("revert of migration %d failed: %w", migration.Version, err)
+			}
+
+			if _, err := m.db.Collection(migrationsCollection).DeleteOne(ctx, bson.M{"_id": migration.Version}); err != nil {
+				return fmt.Errorf("failed to remove migration record %d: %w", migration.Version, err)
+			}
+			reverted = append(reverted, migration.Version)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status 등록된 모든 마이그레이션의 적용 여부 조회
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	done, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, len(m.migrations))
+	for i, migration := range m.migrations {
+		record, applied := done[migration.Version]
+		statuses[i] = MigrationStatus{
+			Version:     migration.Version,
+			Description: migration.Description,
+			Applied:     applied,
+			AppliedAt:   record.AppliedAt,
+			Reversible:  migration.Down != nil,
+		}
+	}
+	return statuses, nil
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int]AppliedMigration, error) {
+	cursor, err := m.db.Collection(migrationsCollection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	var records []AppliedMigration
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	done := make(map[int]AppliedMigration, len(records))
+	for _, record := range records {
+		done[record.Version] = record
+	}
+	return done, nil
+}
+
+// withLock 동시 실행 방지 잠금을 잡은 상태에서 fn 실행
+// 실행하는 동안 잠금 만료 시각을 주기적으로 연장하고, 잠금을 잃으면 fn의 컨텍스트를 취소
+func (m *Migrator) withLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := m.acquireLock(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		// 실행 컨텍스트가 취소되어도 잠금은 해제
+		releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := m.releaseLock(releaseCtx); err != nil {
+			log.Printf("Failed to release migration lock: %v", err)
+		}
+	}()
+
+	runCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	go m.renewLock(runCtx, cancel)
+
+	err := fn(runCtx)
+	if cause := context.Cause(runCtx); err != nil && cause != nil && cause != context.Canceled {
+		return cause
+	}
+	return err
+}
+
+// renewLock ctx가 끝날 때까지 잠금 만료 시각 연장 (다른 프로세스가 잠금을 가져갔으면 cancel 호출)
+func (m *Migrator) renewLock(ctx context.Context, cancel context.CancelCauseFunc) {
+	ticker := time.NewTicker(lockRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		result, err := m.db.Collection(lockCollection).UpdateOne(ctx,
+			bson.M{"_id": lockID, "owner": m.owner},
+			bson.M{"$set": bson.M{"expires_at": time.Now().Add(lockTTL)}},
+		)
+		if err != nil {
+			// 일시적인 장애일 수 있으므로 만료 전까지 다시 시도
+			log.Printf("Failed to renew migration lock: %v", err)
+			continue
+		}
+		if result.MatchedCount == 0 {
+			cancel(errors.New("migration lock was lost to another process"))
+			return
+		}
+	}
+}
+
+func (m *Migrator) acquireLock(ctx context.Context) error {
+	now := time.Now()
+	lock := bson.M{
+		"_id":        lockID,
+		"owner":      m.owner,
+		"locked_at":  now,
+		"expires_at": now.Add(lockTTL),
+	}
+
+	collection := m.db.Collection(lockCollection)
+	_, err := collection.InsertOne(ctx, lock)
+	if err == nil {
+		return nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+
+	// 만료된 잠금이면 인계
+	var previous bson.M
+	err = collection.FindOneAndReplace(ctx,
+		bson.M{"_id": lockID, "expires_at": bson.M{"$lt": now}},
+		lock,
+		options.FindOneAndReplace().SetReturnDocument(options.Before),
+	).Decode(&previous)
+	if err == nil {
+		log.Printf("Took over expired migration lock held by %v", previous["owner"])
+		return nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+
+	var current bson.M
+	if err := collection.FindOne(ctx, bson.M{"_id": lockID}).Decode(&current); err != nil {
+		return errors.New("migration lock is held by another process")
+	}
+	return fmt.Errorf("migration lock is held by %v since %v", current["owner"], current["locked_at"])
+}
+
+func (m *Migrator) releaseLock(ctx context.Context) error {
+	_, err := m.db.Collection(lockCollection).DeleteOne(ctx, bson.M{"_id": lockID, "owner": m.owner})
+	return err
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 주소록 주소 종류
+const (
+	AddressShipping = "shipping"
+	AddressBilling  = "billing"
+	AddressPickup   = "pickup"
+)
+
+// AddressTypes 주소록에서 사용할 수 있는 주소 종류
+var AddressTypes = []string{AddressShipping, AddressBilling, AddressPickup}
+
+// AddressEntry 주소록 항목 (프로필 문서의 addresses 배열에 저장)
+// 종류별로 기본 주소가 하나씩 있으며, 기본 배송지는 기존 address 필드에도 복사됨
+type AddressEntry struct {
+	ID        primitive.ObjectID `bson:"_id" json:"id"`
+	Type      string             `bson:"type" json:"type"`
+	Label     string             `bson:"label,omitempty" json:"label,omitempty"` // 예: 집, 회사
+	Default   bool               `bson:"default" json:"default"`
+	Address   Address            `bson:"address" json:"address"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// AddressRequest 주소록 항목 생성/수정 요청 (수정은 항목 전체를 교체)
+type AddressRequest struct {
+	Type    string  `json:"type"`
+	Label   string  `json:"label"`
+	Default bool    `json:"default"` // true면 같은 종류의 기존 기본 주소를 대신함
+	Address Address `json:"address"`
+}
+
+// AddressBookResponse 주소록 목록 응답
+type AddressBookResponse struct {
+	Items []*AddressEntry `json:"items"`
+}
+
+// FormattedAddress 받는 국가의 관례에 맞게 서식을 지정한 주소
+type FormattedAddress struct {
+	Country string   `json:"country"` // 받는 국가 (ISO 3166-1 alpha-2)
+	Origin  string   `json:"origin,omitempty"`
+	Lines   []string `json:"lines"`
+	Text    string   `json:"text"` // 줄바꿈으로 연결한 전체 주소
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// ProfileFilter 관리자 프로필 목록 필터 (빈 값은 조건 없음)
+type ProfileFilter struct {
+	Status      string
+	Country     string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Email       string // 부분 일치, 대소문자 무시
+	Username    string // 부분 일치, 대소문자 무시
+}
+
+// ListOptions 목록 페이지네이션과 정렬
+type ListOptions struct {
+	Page     int64 // 1부터 시작
+	PageSize int64
+	SortBy   string // created_at, updated_at, username, email
+	SortDesc bool
+}
+
+// Skip 현재 페이지 이전까지 건너뛸 문서 수
+func (o ListOptions) Skip() int64 {
+	return (o.Page - 1) * o.PageSize
+}
+
+type ProfileListResponse struct {
+	Items    []*ProfileResponse `json:"items"`
+	Page     int64              `json:"page"`
+	PageSize int64              `json:"page_size"`
+	Total    int64              `json:"total"`
+}
+
+// UpdateStatusRequest 관리자 상태 변경 요청 (사유 필수)
+type UpdateStatusRequest struct {
+	Status string     `json:"status"`
+	Reason string     `json:"reason"`
+	Until  *time.Time `json:"until,omitempty"` // suspended 전환 시 자동 해제 시각 (없으면 무기한)
+}
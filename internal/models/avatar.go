@@ -0,0 +1,28 @@
+package models
+
+import "io"
+
+// AvatarOriginal 비율을 유지해 축소한 전체 이미지의 크기 이름 (나머지는 정사각형 썸네일, 예: 256x256)
+const AvatarOriginal = "original"
+
+// AvatarImage 업로드한 프로필 이미지의 크기별 파일
+type AvatarImage struct {
+	Size        string `bson:"size" json:"size"` // original 또는 썸네일 크기 (예: 256x256)
+	Key         string `bson:"key" json:"-"`     // 파일 저장소 키
+	URL         string `bson:"url" json:"url"`
+	Width       int    `bson:"width" json:"width"`
+	Height      int    `bson:"height" json:"height"`
+	ContentType string `bson:"content_type" json:"content_type"`
+}
+
+// AvatarUpload 업로드 요청으로 받은 프로필 이미지
+type AvatarUpload struct {
+	ContentType string    // 요청에 지정된 Content-Type (비어 있으면 내용으로 판별)
+	File        io.Reader // 크기 제한은 서비스에서 적용
+}
+
+// AvatarResponse 업로드 후 프로필에 반영된 이미지 URL
+type AvatarResponse struct {
+	Avatar string        `json:"avatar"`
+	Images []AvatarImage `json:"avatar_images"`
+}
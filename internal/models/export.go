@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 데이터 내보내기 작업 상태
+const (
+	ExportPending   = "pending"
+	ExportRunning   = "running"
+	ExportCompleted = "completed"
+	ExportFailed    = "failed"
+)
+
+// 데이터 내보내기 파일 형식
+const (
+	ExportFormatJSON = "json"
+	ExportFormatZip  = "zip"
+)
+
+// ExportJob 사용자 데이터 내보내기 작업 (완료되면 결과 파일을 함께 보관)
+type ExportJob struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	AuthID      primitive.ObjectID `bson:"auth_id" json:"-"`
+	Format      string             `bson:"format" json:"format"`
+	Status      string             `bson:"status" json:"status"`
+	Error       string             `bson:"error,omitempty" json:"error,omitempty"`
+	FileName    string             `bson:"file_name,omitempty" json:"file_name,omitempty"`
+	ContentType string             `bson:"content_type,omitempty" json:"-"`
+	Size        int64              `bson:"size,omitempty" json:"size,omitempty"`
+	Data        []byte             `bson:"data,omitempty" json:"-"`
+	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
+	CompletedAt *time.Time         `bson:"completed_at,omitempty" json:"completed_at,omitempty"`
+	ExpiresAt   *time.Time         `bson:"expires_at,omitempty" json:"expires_at,omitempty"` // 결과 파일 삭제 예정 시각
+
+	// 대기/진행 중인 동안만 auth_id를 담음 (유니크 인덱스로 사용자당 진행 중 작업을 하나로 제한, 저장소가 관리)
+	ActiveAuthID *primitive.ObjectID `bson:"active_auth_id,omitempty" json:"-"`
+}
+
+// IsActive 대기 또는 진행 중인 작업인지 확인
+func (j *ExportJob) IsActive() bool {
+	return j.Status == ExportPending || j.Status == ExportRunning
+}
+
+// ExportJobResponse 내보내기 작업 상태 응답 (완료 시 다운로드 경로 포함)
+type ExportJobResponse struct {
+	*ExportJob
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+type CreateExportRequest struct {
+	Format string `json:"format"` // json(기본값), zip
+}
+
+// UserDataExport 내보내기 파일에 담기는 사용자 데이터 전체
+type UserDataExport struct {
+	ExportedAt    time.Time        `json:"exported_at"`
+	Profile       *ProfileResponse `json:"profile"`
+	Account       ExportAccount    `json:"account"`
+	Privacy       PrivacySettings  `json:"privacy"`
+	Addresses     []*AddressEntry  `json:"addresses"`
+	StatusHistory []*StatusChange  `json:"status_history"`
+}
+
+// ExportAccount 프로필 응답에 포함되지 않는 계정 상태 정보
+type ExportAccount struct {
+	Status          string     `json:"status"`
+	StatusReason    string     `json:"status_reason,omitempty"`
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
+	SuspendedUntil  *time.Time `json:"suspended_until,omitempty"`
+	ErasedAt        *time.Time `json:"erased_at,omitempty"`
+}
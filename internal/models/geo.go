@@ -0,0 +1,80 @@
+package models
+
+import "math"
+
+// GeoPoint GeoJSON Point (좌표 순서는 [경도, 위도])
+type GeoPoint struct {
+	Type        string    `bson:"type" json:"type"`
+	Coordinates []float64 `bson:"coordinates" json:"coordinates"`
+}
+
+// NewGeoPoint 위도/경도로 GeoJSON Point 생성
+func NewGeoPoint(lat, lng float64) *GeoPoint {
+	return &GeoPoint{Type: "Point", Coordinates: []float64{lng, lat}}
+}
+
+// Valid GeoJSON Point 형식과 좌표 범위 확인
+func (p *GeoPoint) Valid() bool {
+	if p.Type != "Point" || len(p.Coordinates) != 2 {
+		return false
+	}
+	lng, lat := p.Coordinates[0], p.Coordinates[1]
+	return lng >= -180 && lng <= 180 && lat >= -90 && lat <= 90
+}
+
+// Lat 위도
+func (p *GeoPoint) Lat() float64 { return p.Coordinates[1] }
+
+// Lng 경도
+func (p *GeoPoint) Lng() float64 { return p.Coordinates[0] }
+
+// Equal 좌표가 같은지 확인
+func (p *GeoPoint) Equal(other *GeoPoint) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	return p.Valid() && other.Valid() && p.Lng() == other.Lng() && p.Lat() == other.Lat()
+}
+
+const earthRadiusMeters = 6371008.8
+
+// DistanceMeters 두 지점 사이의 대권 거리 (haversine)
+func (p *GeoPoint) DistanceMeters(other *GeoPoint) float64 {
+	lat1, lat2 := p.Lat()*math.Pi/180, other.Lat()*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (other.Lng() - p.Lng()) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// NearbyRequest 주변 판매자 검색 요청 (쿼리 파라미터 lat, lng, radius_km, limit)
+type NearbyRequest struct {
+	Lat      *float64
+	Lng      *float64
+	RadiusKm float64 // 0이면 기본값
+	Limit    int64   // 0이면 기본값
+}
+
+// NearbyQuery 저장소 주변 검색 조건 (active이고 위치 공개 설정된 프로필만 대상)
+type NearbyQuery struct {
+	Point        *GeoPoint
+	RadiusMeters float64
+	Limit        int64
+}
+
+// NearbyProfile 기준 지점까지의 거리가 포함된 검색 결과
+type NearbyProfile struct {
+	Profile        *UserProfile
+	DistanceMeters float64
+}
+
+// NearbyItem 주변 검색 응답 항목 (거리는 0.1km 단위로 반올림)
+type NearbyItem struct {
+	*ProfileResponse
+	DistanceKm float64 `json:"distance_km"`
+}
+
+// NearbyResponse 주변 검색 응답 (가까운 순)
+type NearbyResponse struct {
+	Items []NearbyItem `json:"items"`
+}
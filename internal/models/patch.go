@@ -0,0 +1,34 @@
+package models
+
+// ProfilePatch 프로필 패치 요청 본문 (Content-Type에 따라 JSON Merge Patch 또는 JSON Patch)
+type ProfilePatch struct {
+	ContentType string
+	Body        []byte
+}
+
+// ProfilePatchDocument 패치가 적용되는 프로필 문서 (수정 가능한 필드만 포함)
+// 값이 없는 필드는 생략되므로 JSON Patch의 remove/test 대상 여부와 일치
+type ProfilePatchDocument struct {
+	Username    string          `json:"username"`
+	FirstName   string          `json:"first_name"`
+	LastName    string          `json:"last_name"`
+	PhoneNumber string          `json:"phone_number,omitempty"`
+	Address     *Address        `json:"address,omitempty"`
+	Privacy     PrivacySettings `json:"privacy"`
+}
+
+// NewProfilePatchDocument 현재 프로필로 패치 대상 문서 생성
+func NewProfilePatchDocument(profile *UserProfile) *ProfilePatchDocument {
+	doc := &ProfilePatchDocument{
+		Username:    profile.Username,
+		FirstName:   profile.FirstName,
+		LastName:    profile.LastName,
+		PhoneNumber: profile.PhoneNumber,
+		Privacy:     profile.Privacy,
+	}
+	if profile.Address != (Address{}) {
+		address := profile.Address
+		doc.Address = &address
+	}
+	return doc
+}
@@ -0,0 +1,27 @@
+package models
+
+import "strconv"
+
+// Precondition If-Match 헤더로 전달된 조건부 요청 조건
+type Precondition struct {
+	Present  bool    // If-Match 헤더가 있었는지 여부 ("*"는 조건 없음으로 처리)
+	Versions []int64 // 허용되는 프로필 버전 (하나라도 일치하면 통과)
+}
+
+// Matches 현재 프로필 버전이 조건을 만족하는지 확인
+func (p Precondition) Matches(version int64) bool {
+	if !p.Present {
+		return true
+	}
+	for _, v := range p.Versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// ETag 프로필 버전에 대응하는 ETag 값
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
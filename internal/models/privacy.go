@@ -0,0 +1,147 @@
+package models
+
+const RoleAdmin = "admin"
+
+// PrivacySettings 공개 프로필에 노출할 항목 설정
+// 본인과 관리자는 설정과 관계없이 모든 항목을 볼 수 있음
+type PrivacySettings struct {
+	ShowName        bool `bson:"show_name" json:"show_name"`
+	ShowEmail       bool `bson:"show_email" json:"show_email"`
+	ShowPhoneNumber bool `bson:"show_phone_number" json:"show_phone_number"`
+	ShowStreet      bool `bson:"show_street" json:"show_street"`
+	ShowCity        bool `bson:"show_city" json:"show_city"`
+	ShowState       bool `bson:"show_state" json:"show_state"`
+	ShowPostalCode  bool `bson:"show_postal_code" json:"show_postal_code"`
+	ShowCountry     bool `bson:"show_country" json:"show_country"`
+	ShowLocation    bool `bson:"show_location" json:"show_location"` // 좌표 공개 및 주변 검색 노출
+}
+
+// DefaultPrivacySettings 신규 프로필 기본 공개 설정 (이름과 지역만 공개)
+func DefaultPrivacySettings() PrivacySettings {
+	return PrivacySettings{
+		ShowName:    true,
+		ShowCity:    true,
+		ShowState:   true,
+		ShowCountry: true,
+	}
+}
+
+// ProfileView 프로필 조회 범위
+type ProfileView int
+
+const (
+	ViewPublic ProfileView = iota // 비로그인 또는 다른 사용자
+	ViewOwner                     // 프로필 소유자
+	ViewAdmin                     // 관리자
+)
+
+// Viewer 프로필을 조회하는 호출자 (비로그인이면 zero value)
+type Viewer struct {
+	AuthID string
+	Role   string
+}
+
+// IsAdmin 관리자 여부
+func (v Viewer) IsAdmin() bool {
+	return v.Role == RoleAdmin
+}
+
+// ViewOf 호출자가 해당 프로필을 볼 수 있는 범위 결정
+func (v Viewer) ViewOf(profile *UserProfile) ProfileView {
+	switch {
+	case v.AuthID != "" && v.AuthID == profile.AuthID.Hex():
+		return ViewOwner
+	case v.IsAdmin():
+		return ViewAdmin
+	default:
+		return ViewPublic
+	}
+}
+
+// NewProfileResponse 조회 범위에 맞게 프로필 필드를 걸러 응답 생성
+func NewProfileResponse(profile *UserProfile, view ProfileView) *ProfileResponse {
+	resp := &ProfileResponse{
+		ID:        profile.ID,
+		Username:  profile.Username,
+		Avatar:    profile.Avatar,
+		Status:    profile.Status,
+		CreatedAt: profile.CreatedAt,
+		UpdatedAt: profile.UpdatedAt,
+		Version:   profile.Version,
+		View:      view,
+	}
+	resp.PhoneVerified = profile.PhoneVerifiedAt != nil
+	resp.AvatarImages = profile.AvatarImages
+
+	if view == ViewOwner || view == ViewAdmin {
+		authID := profile.AuthID
+		address := profile.Address
+		privacy := profile.Privacy
+
+		resp.AuthID = &authID
+		resp.Email = profile.Email
+		resp.FirstName = profile.FirstName
+		resp.LastName = profile.LastName
+		resp.PhoneNumber = profile.PhoneNumber
+		resp.PhoneE164 = profile.PhoneE164
+		resp.PhoneVerifiedAt = profile.PhoneVerifiedAt
+		resp.Address = &address
+		resp.Privacy = &privacy
+		resp.SuspendedUntil = profile.SuspendedUntil
+
+		if view == ViewAdmin {
+			resp.StatusReason = profile.StatusReason
+			resp.StatusChangedAt = profile.StatusChangedAt
+			resp.StatusChangedBy = profile.StatusChangedBy
+		}
+		return resp
+	}
+
+	privacy := profile.Privacy
+	if privacy.ShowName {
+		resp.FirstName = profile.FirstName
+		resp.LastName = profile.LastName
+	}
+	if privacy.ShowEmail {
+		resp.Email = profile.Email
+	}
+	if privacy.ShowPhoneNumber {
+		resp.PhoneNumber = profile.PhoneNumber
+		resp.PhoneE164 = profile.PhoneE164
+	}
+	resp.Address = publicAddress(profile.Address, privacy)
+
+	return resp
+}
+
+// publicAddress 공개 설정된 주소 항목만 남긴 주소 (공개 항목이 없으면 nil)
+func publicAddress(addr Address, privacy PrivacySettings) *Address {
+	var public Address
+	if privacy.ShowStreet {
+		public.Street = addr.Street
+	}
+	if privacy.ShowCity {
+		public.City = addr.City
+	}
+	if privacy.ShowState {
+		public.State = addr.State
+	}
+	if privacy.ShowPostalCode {
+		public.PostalCode = addr.PostalCode
+	}
+	if privacy.ShowCountry {
+		public.Country = addr.Country
+	}
+	if privacy.ShowLocation {
+		public.Location = addr.Location
+	}
+	if public == (Address{}) {
+		return nil
+	}
+	return &public
+}
+
+// IsOwnedBy 응답의 프로필 소유자 확인 (공개 응답에는 AuthID가 없으므로 항상 false)
+func (r *ProfileResponse) IsOwnedBy(authID string) bool {
+	return r.AuthID != nil && r.AuthID.Hex() == authID
+}
@@ -0,0 +1,126 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SearchRequest 프로필 검색 요청 (쿼리 파라미터 q, city, country, status, limit, cursor)
+type SearchRequest struct {
+	Query   string
+	City    string
+	Country string
+	Status  string // 관리자만 active 외의 상태 지정 가능
+	Limit   int64  // 0이면 기본값
+	Cursor  string // 이전 응답의 next_cursor
+}
+
+// SearchFilter 프로필 검색 조건 (빈 값은 조건 없음)
+type SearchFilter struct {
+	Query   string
+	Status  string
+	City    string // 대소문자 무시 일치
+	Country string // 대소문자 무시 일치
+	// PublicAddressOnly 주소 필터를 공개 설정된 주소에만 적용 (비공개 주소가 검색으로 드러나지 않도록)
+	PublicAddressOnly bool
+	// PublicNameOnly 이름 비공개 프로필은 username에 검색어가 포함될 때만 검색 (비공개 이름으로 검색되지 않도록)
+	// OwnerAuthID의 프로필은 본인이 보므로 제외
+	PublicNameOnly bool
+	OwnerAuthID    *primitive.ObjectID
+}
+
+// SearchCursor 검색 결과 위치 (점수 내림차순, 같은 점수는 _id 오름차순)
+type SearchCursor struct {
+	Score float64            `json:"s"`
+	ID    primitive.ObjectID `json:"i"`
+}
+
+// SearchOptions 검색 페이지 조건
+type SearchOptions struct {
+	Limit int64
+	After *SearchCursor // 이 위치 다음 결과부터 조회 (nil이면 처음부터)
+}
+
+// ScoredProfile 검색 점수가 포함된 검색 결과
+type ScoredProfile struct {
+	Profile *UserProfile
+	Score   float64
+}
+
+// Cursor 이 결과 다음부터 조회하는 커서
+func (p ScoredProfile) Cursor() SearchCursor {
+	return SearchCursor{Score: p.Score, ID: p.Profile.ID}
+}
+
+type SearchResponse struct {
+	Items       []*ProfileResponse `json:"items"`
+	NextCursor  string             `json:"next_cursor,omitempty"`  // 없으면 마지막 페이지
+	Total       int64              `json:"total"`                  // 대략적인 전체 결과 수
+	TotalCapped bool               `json:"total_capped,omitempty"` // 전체 수가 집계 한도를 넘음
+}
+
+// AutocompleteItem 자동완성 결과 (공개 가능한 최소 필드만 포함)
+type AutocompleteItem struct {
+	ID          primitive.ObjectID `json:"id"`
+	Username    string             `json:"username"`
+	DisplayName string             `json:"display_name,omitempty"` // 이름 공개 설정일 때만 포함
+}
+
+// NewAutocompleteItem 프로필을 자동완성 결과로 변환
+func NewAutocompleteItem(profile *UserProfile) AutocompleteItem {
+	item := AutocompleteItem{ID: profile.ID, Username: profile.Username}
+	if profile.Privacy.ShowName {
+		item.DisplayName = strings.TrimSpace(profile.FirstName + " " + profile.LastName)
+	}
+	return item
+}
+
+// AutocompleteResponse 자동완성 응답
+type AutocompleteResponse struct {
+	Items []AutocompleteItem `json:"items"`
+}
+
+// Span 필드 값에서 검색어와 일치한 구간 (rune 단위, End는 포함하지 않음)
+type Span struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// Highlight 검색어와 일치한 필드와 구간
+type Highlight struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+	Spans []Span `json:"spans"`
+}
+
+// FuzzySearchItem 오타 허용 검색 결과 (공개 가능한 최소 필드와 일치 구간)
+type FuzzySearchItem struct {
+	ID          primitive.ObjectID `json:"id"`
+	Username    string             `json:"username"`
+	DisplayName string             `json:"display_name,omitempty"`
+	Score       float64            `json:"score"`
+	Highlights  []Highlight        `json:"highlights"`
+}
+
+// FuzzySearchResponse 오타 허용 검색 응답
+type FuzzySearchResponse struct {
+	Items []FuzzySearchItem `json:"items"`
+}
+
+// SearchIndexReport 검색 인덱스 재구성 결과
+type SearchIndexReport struct {
+	Documents int       `json:"documents"`
+	Trigrams  int       `json:"trigrams"`
+	BuiltAt   time.Time `json:"built_at"`
+	Took      string    `json:"took"` // 재구성에 걸린 시간 (예: "12ms")
+
+	// 인덱스는 인스턴스마다 메모리에 따로 있어 요청을 받은 인스턴스만 재구성됨
+	// 나머지 인스턴스는 SEARCH_INDEX_REBUILD_INTERVAL 주기로 재구성될 때 반영
+	Scope    string `json:"scope"`    // SearchIndexScopeInstance
+	Instance string `json:"instance"` // 재구성한 인스턴스 (호스트 이름)
+}
+
+// SearchIndexScopeInstance 재구성이 요청을 받은 인스턴스에만 적용됨
+const SearchIndexScopeInstance = "instance"
@@ -0,0 +1,62 @@
+package models
+
+import "time"
+
+// 프로필 상태
+const (
+	StatusActive              = "active"
+	StatusInactive            = "inactive" // 본인 탈퇴 (soft delete)
+	StatusSuspended           = "suspended"
+	StatusBanned              = "banned"
+	StatusPendingVerification = "pending_verification"
+	StatusErased              = "erased" // 개인정보 파기 후 남는 tombstone (최종 상태)
+)
+
+// 상태 변경 주체 종류
+const (
+	ActorOwner  = "owner"
+	ActorAdmin  = "admin"
+	ActorSystem = "system" // 정지 기간 만료 등 자동 처리
+)
+
+// Actor 상태를 변경하는 주체
+type Actor struct {
+	Type string
+	ID   string // Auth ID (system이면 비어 있음)
+}
+
+var SystemActor = Actor{Type: ActorSystem}
+
+// StatusChange 상태 변경 이력 항목
+type StatusChange struct {
+	From      string     `bson:"from" json:"from"`
+	To        string     `bson:"to" json:"to"`
+	Reason    string     `bson:"reason" json:"reason"`
+	ActorType string     `bson:"actor_type" json:"actor_type"`
+	ActorID   string     `bson:"actor_id,omitempty" json:"actor_id,omitempty"`
+	Until     *time.Time `bson:"until,omitempty" json:"until,omitempty"` // 정지 해제 예정 시각
+	At        time.Time  `bson:"at" json:"at"`
+}
+
+// ErasureReport 프로필 개인정보 파기 결과
+type ErasureReport struct {
+	ProfileID    string    `json:"profile_id"`
+	AuthID       string    `json:"auth_id"`
+	Trigger      string    `json:"trigger"` // scheduled, admin
+	ErasedFields []string  `json:"erased_fields"`
+	ErasedAt     time.Time `json:"erased_at"`
+}
+
+// PurgeReport 유예 기간이 지난 탈퇴 프로필 일괄 파기 결과
+type PurgeReport struct {
+	StartedAt  time.Time        `json:"started_at"`
+	FinishedAt time.Time        `json:"finished_at"`
+	Erased     []*ErasureReport `json:"erased"`
+	Failed     []string         `json:"failed"` // 파기에 실패한 프로필 ID
+}
+
+type StatusHistoryResponse struct {
+	ProfileID string          `json:"profile_id"`
+	Status    string          `json:"status"`
+	History   []*StatusChange `json:"history"`
+}
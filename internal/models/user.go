@@ -1,9 +1,12 @@
 package models
 
 import (
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
 )
 
 type UserProfile struct {
@@ -11,42 +14,121 @@ type UserProfile struct {
 	AuthID      primitive.ObjectID `bson:"auth_id" json:"auth_id"` // Auth Service의 사용자 ID
 	Email       string             `bson:"email" json:"email"`     // Auth Service와 동기화
 	Username    string             `bson:"username" json:"username"`
+	UsernameKey string             `bson:"username_key,omitempty" json:"-"` // 중복 확인용 정규화 값 (utils.UsernameKey)
 	FirstName   string             `bson:"first_name" json:"first_name"`
 	LastName    string             `bson:"last_name" json:"last_name"`
-	PhoneNumber string             `bson:"phone_number" json:"phone_number"`
+	PhoneNumber string             `bson:"phone_number" json:"phone_number"`                 // 국제 표기 (+82 10-1234-5678)
+	PhoneE164   string             `bson:"phone_e164,omitempty" json:"phone_e164,omitempty"` // 중복 확인용 정규화 값 (+821012345678)
 	Address     Address            `bson:"address" json:"address"`
 	Avatar      string             `bson:"avatar" json:"avatar"` // 프로필 이미지 URL
-	Status      string             `bson:"status" json:"status"` // active, inactive, suspended
+	Status      string             `bson:"status" json:"status"` // active, inactive, suspended, banned, pending_verification
+	Privacy     PrivacySettings    `bson:"privacy" json:"privacy"`
 	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+	Version     int64              `bson:"version" json:"-"` // 변경될 때마다 1씩 증가 (ETag, 낙관적 동시성 제어)
+
+	// 마지막 상태 변경 정보
+	StatusReason    string          `bson:"status_reason,omitempty" json:"status_reason,omitempty"`
+	StatusChangedAt *time.Time      `bson:"status_changed_at,omitempty" json:"status_changed_at,omitempty"`
+	StatusChangedBy string          `bson:"status_changed_by,omitempty" json:"status_changed_by,omitempty"` // 변경한 주체의 Auth ID (system이면 비어 있음)
+	SuspendedUntil  *time.Time      `bson:"suspended_until,omitempty" json:"suspended_until,omitempty"`     // 기간 정지 자동 해제 시각
+	StatusHistory   []*StatusChange `bson:"status_history,omitempty" json:"status_history,omitempty"`       // 최근 상태 변경 이력
+	ErasedAt        *time.Time      `bson:"erased_at,omitempty" json:"erased_at,omitempty"`                 // 개인정보 파기 시각
+
+	SearchKeys []string        `bson:"search_keys,omitempty" json:"-"` // 자동완성용 접두어 검색 키 (BuildSearchKeys)
+	Addresses  []*AddressEntry `bson:"addresses,omitempty" json:"-"`   // 주소록 (/users/me/addresses)
+
+	// 인증번호로 전화번호를 확인한 시각 (번호를 바꾸면 제거)
+	PhoneVerifiedAt *time.Time `bson:"phone_verified_at,omitempty" json:"phone_verified_at,omitempty"`
+
+	// 업로드한 프로필 이미지의 크기별 파일 (Avatar는 그중 original의 URL)
+	AvatarImages []AvatarImage `bson:"avatar_images,omitempty" json:"avatar_images,omitempty"`
 }
 
 type Address struct {
-	Street     string `bson:"street" json:"street"`
-	City       string `bson:"city" json:"city"`
-	State      string `bson:"state" json:"state"`
-	PostalCode string `bson:"postal_code" json:"postal_code"`
-	Country    string `bson:"country" json:"country"`
+	Street     string `bson:"street" json:"street,omitempty"`
+	City       string `bson:"city" json:"city,omitempty"`
+	State      string `bson:"state" json:"state,omitempty"`
+	PostalCode string `bson:"postal_code" json:"postal_code,omitempty"`
+	Country    string `bson:"country" json:"country,omitempty"`
+
+	// Location 좌표 (직접 지정하거나 주소로 지오코딩, 없으면 주변 검색 대상에서 제외)
+	Location *GeoPoint `bson:"location,omitempty" json:"location,omitempty"`
 }
 
 // API 요청/응답 구조체
 type CreateProfileRequest struct {
-	Username    string  `json:"username"`
-	FirstName   string  `json:"first_name"`
-	LastName    string  `json:"last_name"`
-	PhoneNumber string  `json:"phone_number"`
-	Address     Address `json:"address"`
+	Username    string           `json:"username"`
+	FirstName   string           `json:"first_name"`
+	LastName    string           `json:"last_name"`
+	PhoneNumber string           `json:"phone_number"`
+	Address     Address          `json:"address"`
+	Privacy     *PrivacySettings `json:"privacy,omitempty"` // 없으면 기본 공개 설정 사용
 }
 
 type UpdateProfileRequest struct {
-	Username    *string  `json:"username,omitempty"`
-	FirstName   *string  `json:"first_name,omitempty"`
-	LastName    *string  `json:"last_name,omitempty"`
-	PhoneNumber *string  `json:"phone_number,omitempty"`
-	Address     *Address `json:"address,omitempty"`
+	Username    *string          `json:"username,omitempty"`
+	FirstName   *string          `json:"first_name,omitempty"`
+	LastName    *string          `json:"last_name,omitempty"`
+	PhoneNumber *string          `json:"phone_number,omitempty"`
+	Address     *Address         `json:"address,omitempty"`
+	Privacy     *PrivacySettings `json:"privacy,omitempty"`
 }
 
+// ProfileResponse 조회자 권한에 따라 필드가 걸러진 프로필
+// 공개 조회에서는 비공개 필드가 비어 있으며 JSON에서 생략됨
 type ProfileResponse struct {
-	UserProfile
-	// 추가 필드가 필요한 경우 여기에 정의
+	ID          primitive.ObjectID  `json:"id"`
+	AuthID      *primitive.ObjectID `json:"auth_id,omitempty"`
+	Email       string              `json:"email,omitempty"`
+	Username    string              `json:"username"`
+	FirstName   string              `json:"first_name,omitempty"`
+	LastName    string              `json:"last_name,omitempty"`
+	PhoneNumber string              `json:"phone_number,omitempty"`
+	PhoneE164   string              `json:"phone_e164,omitempty"`
+	Address     *Address            `json:"address,omitempty"`
+	Avatar      string              `json:"avatar"`
+	Status      string              `json:"status"`
+	Privacy     *PrivacySettings    `json:"privacy,omitempty"` // 본인, 관리자에게만 노출
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+	Version     int64               `json:"-"` // ETag 헤더로 전달
+	View        ProfileView         `json:"-"` // 응답을 만든 조회 범위 (ETag, 캐시 헤더 결정)
+
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"` // 본인, 관리자에게만 노출
+
+	// 전화번호 인증 여부는 번호를 공개하지 않아도 노출 (인증 시각은 본인, 관리자에게만)
+	PhoneVerified   bool       `json:"phone_verified"`
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at,omitempty"`
+
+	AvatarImages []AvatarImage `json:"avatar_images,omitempty"` // 업로드한 프로필 이미지의 크기별 URL
+
+	// 관리자에게만 노출
+	StatusReason    string     `json:"status_reason,omitempty"`
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
+	StatusChangedBy string     `json:"status_changed_by,omitempty"`
+}
+
+// BuildSearchKeys 자동완성용 검색 키 생성 (username과 표시 이름)
+// 이름 비공개 프로필은 이름으로 검색되지 않도록 username만 포함
+func BuildSearchKeys(profile *UserProfile) []string {
+	keys := []string{utils.FoldSearchKey(profile.Username)}
+	if profile.Privacy.ShowName {
+		first := utils.FoldSearchKey(profile.FirstName)
+		last := utils.FoldSearchKey(profile.LastName)
+		keys = append(keys, first, last,
+			strings.TrimSpace(first+" "+last), // 서양식 "Min Kim"
+			last+first,                        // 한국식 "김민수"
+		)
+	}
+
+	seen := map[string]bool{}
+	unique := keys[:0]
+	for _, key := range keys {
+		if key != "" && !seen[key] {
+			seen[key] = true
+			unique = append(unique, key)
+		}
+	}
+	return unique
 }
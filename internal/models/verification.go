@@ -0,0 +1,84 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PhoneVerification 전화번호 인증번호 발송 기록
+// 인증번호는 솔트를 붙인 해시로만 보관하며, 발송 횟수 제한 계산을 위해 사용 후에도 보관 기간 동안 남겨 둠
+type PhoneVerification struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	AuthID     primitive.ObjectID `bson:"auth_id"`
+	PhoneE164  string             `bson:"phone_e164"`
+	CodeHash   string             `bson:"code_hash"`
+	Salt       string             `bson:"salt"`
+	Attempts   int                `bson:"attempts"` // 틀린 인증번호를 포함한 확인 시도 횟수
+	CreatedAt  time.Time          `bson:"created_at"`
+	ExpiresAt  time.Time          `bson:"expires_at"`
+	ConsumedAt *time.Time         `bson:"consumed_at,omitempty"` // 인증 완료 시각
+}
+
+// SendLimit 인증번호 발송 제한 (최근 Window 동안 Max회, 직전 발송 후 Interval 동안 재발송 불가)
+type SendLimit struct {
+	Window   time.Duration
+	Max      int
+	Interval time.Duration // 0이면 간격 제한 없음
+}
+
+// Allows sends(발송 시각 목록)에 더해 at에 발송해도 한도를 넘지 않는지 확인
+func (l SendLimit) Allows(sends []time.Time, at time.Time) bool {
+	recent := l.recent(sends, at)
+	if len(recent) >= l.Max {
+		return false
+	}
+	return len(recent) == 0 || !recent[len(recent)-1].Add(l.Interval).After(at)
+}
+
+// RetryAt 한도에 걸린 발송을 다시 할 수 있는 시각
+// 재발송 간격이 끝나는 시각과 기간 안에서 가장 오래된 발송이 기간을 벗어나는 시각 중 늦은 쪽
+func (l SendLimit) RetryAt(sends []time.Time, at time.Time) time.Time {
+	recent := l.recent(sends, at)
+	retryAt := at
+	if n := len(recent); n > 0 && l.Interval > 0 {
+		retryAt = later(retryAt, recent[n-1].Add(l.Interval))
+	}
+	if n := len(recent); n >= l.Max && l.Max > 0 {
+		retryAt = later(retryAt, recent[n-l.Max].Add(l.Window))
+	}
+	return retryAt
+}
+
+// recent at 기준 Window 안의 발송 시각 (오래된 순)
+func (l SendLimit) recent(sends []time.Time, at time.Time) []time.Time {
+	since := at.Add(-l.Window)
+	recent := make([]time.Time, 0, len(sends))
+	for _, sent := range sends {
+		if sent.After(since) {
+			recent = append(recent, sent)
+		}
+	}
+	sort.Slice(recent, func(i, j int) bool { return recent[i].Before(recent[j]) })
+	return recent
+}
+
+func later(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// PhoneVerificationResponse 인증번호 발송 결과
+type PhoneVerificationResponse struct {
+	PhoneNumber string    `json:"phone_number"` // 가운데 자리를 가린 번호
+	ExpiresAt   time.Time `json:"expires_at"`
+	ResendAfter time.Time `json:"resend_after"` // 이 시각 이후에 다시 발송 가능
+}
+
+// ConfirmPhoneRequest 인증번호 확인 요청
+type ConfirmPhoneRequest struct {
+	Code string `json:"code"`
+}
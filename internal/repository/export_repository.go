@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/apperror"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+var (
+	ErrExportNotFound   = apperror.New(apperror.NotFound, "export_not_found", "export not found")
+	ErrExportInProgress = apperror.New(apperror.Conflict, "export_in_progress", "an export is already in progress")
+)
+
+// ExportRepository 사용자 데이터 내보내기 작업 저장소 인터페이스
+// 조회 메서드는 작업이 없으면 (nil, nil)을 반환
+type ExportRepository interface {
+	// CreateExport 같은 사용자의 대기/진행 중 작업이 이미 있으면 ErrExportInProgress 반환
+	CreateExport(ctx context.Context, job *models.ExportJob) error
+	// UpdateExport 작업 문서 전체 교체 (결과 파일 포함)
+	UpdateExport(ctx context.Context, job *models.ExportJob) error
+	GetExport(ctx context.Context, id primitive.ObjectID) (*models.ExportJob, error)
+	// AbandonStaleExports before 이전에 생성되어 아직 대기/진행 중인 작업을 실패로 처리 (중단된 작업이 새 요청을 막지 않도록)
+	AbandonStaleExports(ctx context.Context, authID primitive.ObjectID, before time.Time) error
+	// DeleteExpiredExports 보관 기간이 지난 작업 삭제 후 삭제 개수 반환
+	DeleteExpiredExports(ctx context.Context, now time.Time) (int64, error)
+	DeleteExportsByAuthID(ctx context.Context, authID primitive.ObjectID) (int64, error)
+}
@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+// clone BSON 왕복 변환으로 프로필 깊은 복사
+func clone(profile *models.UserProfile) (*models.UserProfile, error) {
+	data, err := bson.Marshal(profile)
+	if err != nil {
+		return nil, err
+	}
+	var copied models.UserProfile
+	if err := bson.Unmarshal(data, &copied); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// applyUpdate MongoDB $set, $unset 연산을 프로필에 적용한 새 프로필 반환
+// "address.city" 같은 점 표기 경로를 지원
+func applyUpdate(profile *models.UserProfile, set bson.M, unset []string) (*models.UserProfile, error) {
+	doc, err := toDocument(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	for path, value := range set {
+		setPath(doc, path, value)
+	}
+	for _, path := range unset {
+		unsetPath(doc, path)
+	}
+
+	return fromDocument(doc)
+}
+
+func toDocument(profile *models.UserProfile) (bson.M, error) {
+	data, err := bson.Marshal(profile)
+	if err != nil {
+		return nil, err
+	}
+	var d bson.D
+	if err := bson.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+	return toMap(d), nil
+}
+
+func fromDocument(doc bson.M) (*models.UserProfile, error) {
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	var profile models.UserProfile
+	if err := bson.Unmarshal(data, &profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// toMap 중첩 문서까지 bson.M으로 변환
+func toMap(d bson.D) bson.M {
+	m := make(bson.M, len(d))
+	for _, e := range d {
+		m[e.Key] = normalize(e.Value)
+	}
+	return m
+}
+
+func normalize(v interface{}) interface{} {
+	switch val := v.(type) {
+	case primitive.D:
+		return toMap(val)
+	case primitive.A:
+		arr := make(primitive.A, len(val))
+		for i, item := range val {
+			arr[i] = normalize(item)
+		}
+		return arr
+	default:
+		return v
+	}
+}
+
+func setPath(doc bson.M, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+	current := doc
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := current[key].(bson.M)
+		if !ok {
+			next = bson.M{}
+			current[key] = next
+		}
+		current = next
+	}
+	current[keys[len(keys)-1]] = value
+}
+
+func unsetPath(doc bson.M, path string) {
+	keys := strings.Split(path, ".")
+	current := doc
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := current[key].(bson.M)
+		if !ok {
+			return
+		}
+		current = next
+	}
+	delete(current, keys[len(keys)-1])
+}
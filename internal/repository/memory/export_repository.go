@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+)
+
+var _ repository.ExportRepository = (*ExportRepository)(nil)
+
+// ExportRepository 인메모리 데이터 내보내기 작업 저장소
+type ExportRepository struct {
+	mu   sync.RWMutex
+	jobs map[primitive.ObjectID]*models.ExportJob
+}
+
+func NewExportRepository() *ExportRepository {
+	return &ExportRepository{
+		jobs: make(map[primitive.ObjectID]*models.ExportJob),
+	}
+}
+
+func (r *ExportRepository) CreateExport(ctx context.Context, job *models.ExportJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 사용자당 대기/진행 중 작업 하나 (mongodb의 active_auth_id_unique 인덱스와 동일)
+	if job.IsActive() {
+		for _, other := range r.jobs {
+			if other.AuthID == job.AuthID && other.IsActive() {
+				return repository.ErrExportInProgress
+			}
+		}
+	}
+	if job.ID.IsZero() {
+		job.ID = primitive.NewObjectID()
+	}
+	r.jobs[job.ID] = copyExport(job)
+	return nil
+}
+
+func (r *ExportRepository) UpdateExport(ctx context.Context, job *models.ExportJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.jobs[job.ID]; !ok {
+		return repository.ErrExportNotFound
+	}
+	r.jobs[job.ID] = copyExport(job)
+	return nil
+}
+
+func (r *ExportRepository) GetExport(ctx context.Context, id primitive.ObjectID) (*models.ExportJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, nil
+	}
+	return copyExport(job), nil
+}
+
+func (r *ExportRepository) AbandonStaleExports(ctx context.Context, authID primitive.ObjectID, before time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, job := range r.jobs {
+		if job.AuthID != authID || !job.IsActive() || !job.CreatedAt.Before(before) {
+			continue
+		}
+		job.Status = models.ExportFailed
+		job.Error = "export timed out"
+		job.CompletedAt = &now
+	}
+	return nil
+}
+
+func (r *ExportRepository) DeleteExpiredExports(ctx context.Context, now time.Time) (int64, error) {
+	return r.deleteWhere(func(job *models.ExportJob) bool {
+		return job.ExpiresAt != nil && !job.ExpiresAt.After(now)
+	}), nil
+}
+
+func (r *ExportRepository) DeleteExportsByAuthID(ctx context.Context, authID primitive.ObjectID) (int64, error) {
+	return r.deleteWhere(func(job *models.ExportJob) bool {
+		return job.AuthID == authID
+	}), nil
+}
+
+func (r *ExportRepository) deleteWhere(match func(*models.ExportJob) bool) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for id, job := range r.jobs {
+		if match(job) {
+			delete(r.jobs, id)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// copyExport 호출자가 저장된 작업을 변경하지 못하도록 복사 (결과 파일은 변경하지 않으므로 공유)
+func copyExport(job *models.ExportJob) *models.ExportJob {
+	copied := *job
+	return &copied
+}
@@ -0,0 +1,530 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+)
+
+var _ repository.UserRepository = (*UserRepository)(nil)
+
+// UserRepository MongoDB 없이 동작하는 인메모리 프로필 저장소
+// 테스트와 로컬 데모용이며 MongoDB 구현과 동일한 의미를 따름
+type UserRepository struct {
+	mu       sync.RWMutex
+	profiles map[primitive.ObjectID]*models.UserProfile
+}
+
+func NewUserRepository() *UserRepository {
+	return &UserRepository{
+		profiles: make(map[primitive.ObjectID]*models.UserProfile),
+	}
+}
+
+func (r *UserRepository) CreateProfile(ctx context.Context, profile *models.UserProfile) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// auth_id, username, username_key, phone_e164 유니크 인덱스와 동일한 중복 검사
+	for _, existing := range r.profiles {
+		if existing.AuthID == profile.AuthID {
+			return repository.ErrProfileExists
+		}
+		if existing.Username == profile.Username {
+			return repository.ErrUsernameTaken
+		}
+		if profile.UsernameKey != "" && existing.UsernameKey == profile.UsernameKey {
+			return repository.ErrUsernameTaken
+		}
+		if profile.PhoneE164 != "" && existing.PhoneE164 == profile.PhoneE164 {
+			return repository.ErrPhoneTaken
+		}
+	}
+
+	profile.CreatedAt = time.Now()
+	profile.UpdatedAt = time.Now()
+	profile.Status = models.StatusActive
+	profile.Version = 1
+	if profile.ID.IsZero() {
+		profile.ID = primitive.NewObjectID()
+	} else if _, ok := r.profiles[profile.ID]; ok {
+		return repository.ErrProfileExists
+	}
+
+	stored, err := clone(profile)
+	if err != nil {
+		return err
+	}
+	r.profiles[profile.ID] = stored
+	return nil
+}
+
+func (r *UserRepository) GetProfileByID(ctx context.Context, id primitive.ObjectID) (*models.UserProfile, error) {
+	return r.findOne(func(p *models.UserProfile) bool { return p.ID == id })
+}
+
+func (r *UserRepository) GetProfileByAuthID(ctx context.Context, authID primitive.ObjectID) (*models.UserProfile, error) {
+	return r.findOne(func(p *models.UserProfile) bool { return p.AuthID == authID })
+}
+
+func (r *UserRepository) GetProfileByUsername(ctx context.Context, username string) (*models.UserProfile, error) {
+	return r.findOne(func(p *models.UserProfile) bool { return p.Username == username })
+}
+
+func (r *UserRepository) GetProfileByPhone(ctx context.Context, e164 string) (*models.UserProfile, error) {
+	return r.findOne(func(p *models.UserProfile) bool { return p.PhoneE164 == e164 })
+}
+
+func (r *UserRepository) GetProfileByUsernameKey(ctx context.Context, key string) (*models.UserProfile, error) {
+	return r.findOne(func(p *models.UserProfile) bool { return p.UsernameKey == key })
+}
+
+func (r *UserRepository) UpdateProfile(ctx context.Context, id primitive.ObjectID, version int64, update repository.ProfileUpdate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	profile, ok := r.profiles[id]
+	if !ok {
+		return repository.ErrProfileNotFound
+	}
+	if profile.Version != version {
+		return repository.ErrVersionConflict
+	}
+
+	set := bson.M{"updated_at": time.Now()}
+	for path, value := range update.Set {
+		set[path] = value
+	}
+	updated, err := applyUpdate(profile, set, update.Unset)
+	if err != nil {
+		return err
+	}
+	updated.Version = profile.Version + 1
+
+	// username, username_key, phone_e164 유니크 제약 유지
+	for otherID, other := range r.profiles {
+		if otherID == id {
+			continue
+		}
+		if other.Username == updated.Username {
+			return repository.ErrUsernameTaken
+		}
+		if updated.UsernameKey != "" && other.UsernameKey == updated.UsernameKey {
+			return repository.ErrUsernameTaken
+		}
+		if updated.PhoneE164 != "" && other.PhoneE164 == updated.PhoneE164 {
+			return repository.ErrPhoneTaken
+		}
+	}
+
+	r.profiles[id] = updated
+	return nil
+}
+
+func (r *UserRepository) ChangeStatus(ctx context.Context, id primitive.ObjectID, version int64, change *models.StatusChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	profile, ok := r.profiles[id]
+	if !ok {
+		return repository.ErrProfileNotFound
+	}
+	if profile.Status != change.From {
+		return repository.ErrStatusConflict
+	}
+	if profile.Version != version {
+		return repository.ErrVersionConflict
+	}
+
+	updated, err := clone(profile)
+	if err != nil {
+		return err
+	}
+	at := change.At
+	updated.Status = change.To
+	updated.StatusReason = change.Reason
+	updated.StatusChangedAt = &at
+	updated.StatusChangedBy = change.ActorID
+	updated.SuspendedUntil = change.Until
+	updated.UpdatedAt = at
+	updated.Version++
+
+	entry := *change
+	updated.StatusHistory = append(updated.StatusHistory, &entry)
+	if n := len(updated.StatusHistory); n > repository.MaxStatusHistory {
+		updated.StatusHistory = updated.StatusHistory[n-repository.MaxStatusHistory:]
+	}
+
+	r.profiles[id] = updated
+	return nil
+}
+
+func (r *UserRepository) ListExpiredSuspensions(ctx context.Context, now time.Time, limit int64) ([]*models.UserProfile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var profiles []*models.UserProfile
+	for _, profile := range r.profiles {
+		if limit > 0 && int64(len(profiles)) >= limit {
+			break
+		}
+		if profile.Status != models.StatusSuspended || profile.SuspendedUntil == nil || profile.SuspendedUntil.After(now) {
+			continue
+		}
+		copied, err := clone(profile)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, copied)
+	}
+	return profiles, nil
+}
+
+func (r *UserRepository) ListExpiredDeletions(ctx context.Context, cutoff time.Time, limit int64) ([]*models.UserProfile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var profiles []*models.UserProfile
+	for _, profile := range r.profiles {
+		if limit > 0 && int64(len(profiles)) >= limit {
+			break
+		}
+		if profile.Status != models.StatusInactive || profile.StatusChangedAt == nil || profile.StatusChangedAt.After(cutoff) {
+			continue
+		}
+		copied, err := clone(profile)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, copied)
+	}
+	return profiles, nil
+}
+
+func (r *UserRepository) EraseProfile(ctx context.Context, id primitive.ObjectID, fromStatus string, tombstone *models.UserProfile) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	profile, ok := r.profiles[id]
+	if !ok {
+		return repository.ErrProfileNotFound
+	}
+	if profile.Status != fromStatus {
+		return repository.ErrStatusConflict
+	}
+
+	stored, err := clone(tombstone)
+	if err != nil {
+		return err
+	}
+	stored.ID = id
+	r.profiles[id] = stored
+	return nil
+}
+
+func (r *UserRepository) SearchProfiles(ctx context.Context, filter models.SearchFilter, opts models.SearchOptions) ([]models.ScoredProfile, int64, error) {
+	terms := tokenize(filter.Query)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []models.ScoredProfile
+	for _, profile := range r.profiles {
+		if !matchesSearchFilter(profile, filter) {
+			continue
+		}
+		if score := textScore(profile, terms); score > 0 {
+			matches = append(matches, models.ScoredProfile{Profile: profile, Score: float64(score)})
+		}
+	}
+
+	total := int64(len(matches))
+	if total > repository.MaxSearchCount {
+		total = repository.MaxSearchCount
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return searchBefore(matches[i].Cursor(), matches[j].Cursor())
+	})
+
+	results := []models.ScoredProfile{}
+	for _, m := range matches {
+		if opts.After != nil && !searchBefore(*opts.After, m.Cursor()) {
+			continue
+		}
+		if int64(len(results)) == opts.Limit {
+			break
+		}
+		profile, err := clone(m.Profile)
+		if err != nil {
+			return nil, 0, err
+		}
+		results = append(results, models.ScoredProfile{Profile: profile, Score: m.Score})
+	}
+	return results, total, nil
+}
+
+// searchBefore 검색 결과 순서 (점수 내림차순, 같은 점수는 _id 오름차순)
+func searchBefore(a, b models.SearchCursor) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.ID.Hex() < b.ID.Hex()
+}
+
+func matchesSearchFilter(profile *models.UserProfile, filter models.SearchFilter) bool {
+	if filter.Status != "" && profile.Status != filter.Status {
+		return false
+	}
+	if filter.City != "" && (!strings.EqualFold(profile.Address.City, filter.City) ||
+		(filter.PublicAddressOnly && !profile.Privacy.ShowCity)) {
+		return false
+	}
+	if filter.Country != "" && (!strings.EqualFold(profile.Address.Country, filter.Country) ||
+		(filter.PublicAddressOnly && !profile.Privacy.ShowCountry)) {
+		return false
+	}
+	if filter.PublicNameOnly && !profile.Privacy.ShowName && !containsAnyTerm(profile.Username, filter.Query) &&
+		(filter.OwnerAuthID == nil || profile.AuthID != *filter.OwnerAuthID) {
+		return false
+	}
+	return true
+}
+
+// containsAnyTerm 검색어 중 하나라도 포함하는지 대소문자를 무시해 확인
+func containsAnyTerm(s, query string) bool {
+	s = strings.ToLower(s)
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		if strings.Contains(s, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *UserRepository) AutocompleteProfiles(ctx context.Context, prefix string, limit int64) ([]*models.UserProfile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []*models.UserProfile
+	for _, profile := range r.profiles {
+		if profile.Status == models.StatusActive && hasKeyPrefix(profile.SearchKeys, prefix) {
+			matches = append(matches, profile)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Username < matches[j].Username
+	})
+
+	profiles := []*models.UserProfile{}
+	for _, profile := range matches {
+		if int64(len(profiles)) == limit {
+			break
+		}
+		copied, err := clone(profile)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, copied)
+	}
+	return profiles, nil
+}
+
+func hasKeyPrefix(keys []string, prefix string) bool {
+	for _, key := range keys {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *UserRepository) FindNearbyProfiles(ctx context.Context, query models.NearbyQuery) ([]models.NearbyProfile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []models.NearbyProfile
+	for _, profile := range r.profiles {
+		location := profile.Address.Location
+		if profile.Status != models.StatusActive || !profile.Privacy.ShowLocation || location == nil || !location.Valid() {
+			continue
+		}
+		if distance := query.Point.DistanceMeters(location); distance <= query.RadiusMeters {
+			matches = append(matches, models.NearbyProfile{Profile: profile, DistanceMeters: distance})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].DistanceMeters != matches[j].DistanceMeters {
+			return matches[i].DistanceMeters < matches[j].DistanceMeters
+		}
+		return matches[i].Profile.ID.Hex() < matches[j].Profile.ID.Hex()
+	})
+
+	results := []models.NearbyProfile{}
+	for _, m := range matches {
+		if int64(len(results)) == query.Limit {
+			break
+		}
+		profile, err := clone(m.Profile)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, models.NearbyProfile{Profile: profile, DistanceMeters: m.DistanceMeters})
+	}
+	return results, nil
+}
+
+func (r *UserRepository) ListProfiles(ctx context.Context, filter models.ProfileFilter, opts models.ListOptions) ([]*models.UserProfile, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []*models.UserProfile
+	for _, profile := range r.profiles {
+		if matchesFilter(profile, filter) {
+			matches = append(matches, profile)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if opts.SortDesc {
+			a, b = b, a
+		}
+		if c := compareField(a, b, opts.SortBy); c != 0 {
+			return c < 0
+		}
+		return a.ID.Hex() < b.ID.Hex()
+	})
+
+	total := int64(len(matches))
+	start := opts.Skip()
+	if start > total {
+		start = total
+	}
+	end := start + opts.PageSize
+	if end > total {
+		end = total
+	}
+
+	profiles := make([]*models.UserProfile, 0, end-start)
+	for _, profile := range matches[start:end] {
+		copied, err := clone(profile)
+		if err != nil {
+			return nil, 0, err
+		}
+		profiles = append(profiles, copied)
+	}
+	return profiles, total, nil
+}
+
+func (r *UserRepository) ScanProfiles(ctx context.Context, filter models.ProfileFilter, fn func(*models.UserProfile) error) error {
+	// 콜백에서 저장소를 다시 호출할 수 있도록 잠금 밖에서 전달
+	r.mu.RLock()
+	var matches []*models.UserProfile
+	for _, profile := range r.profiles {
+		if !matchesFilter(profile, filter) {
+			continue
+		}
+		copied, err := clone(profile)
+		if err != nil {
+			r.mu.RUnlock()
+			return err
+		}
+		matches = append(matches, copied)
+	}
+	r.mu.RUnlock()
+
+	for _, profile := range matches {
+		if err := fn(profile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func matchesFilter(profile *models.UserProfile, filter models.ProfileFilter) bool {
+	if filter.Status != "" && profile.Status != filter.Status {
+		return false
+	}
+	if filter.Country != "" && !strings.EqualFold(profile.Address.Country, filter.Country) {
+		return false
+	}
+	if filter.Email != "" && !containsFold(profile.Email, filter.Email) {
+		return false
+	}
+	if filter.Username != "" && !containsFold(profile.Username, filter.Username) {
+		return false
+	}
+	if filter.CreatedFrom != nil && profile.CreatedAt.Before(*filter.CreatedFrom) {
+		return false
+	}
+	if filter.CreatedTo != nil && !profile.CreatedAt.Before(*filter.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+// compareField 정렬 필드 기준 비교 (-1, 0, 1)
+func compareField(a, b *models.UserProfile, field string) int {
+	switch field {
+	case "updated_at":
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	case "username":
+		return strings.Compare(a.Username, b.Username)
+	case "email":
+		return strings.Compare(a.Email, b.Email)
+	default:
+		return a.CreatedAt.Compare(b.CreatedAt)
+	}
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// findOne 조건에 맞는 첫 프로필의 복사본 반환 (없으면 nil)
+func (r *UserRepository) findOne(match func(*models.UserProfile) bool) (*models.UserProfile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, profile := range r.profiles {
+		if match(profile) {
+			return clone(profile)
+		}
+	}
+	return nil, nil
+}
+
+// textScore MongoDB 텍스트 인덱스(username, first_name, last_name)를 흉내낸 점수
+func textScore(profile *models.UserProfile, terms []string) int {
+	var fields []string
+	fields = append(fields, tokenize(profile.Username)...)
+	fields = append(fields, tokenize(profile.FirstName)...)
+	fields = append(fields, tokenize(profile.LastName)...)
+
+	score := 0
+	for _, term := range terms {
+		for _, field := range fields {
+			if term == field {
+				score++
+			}
+		}
+	}
+	return score
+}
+
+// tokenize 문자/숫자가 아닌 문자를 기준으로 소문자 단어 분리
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
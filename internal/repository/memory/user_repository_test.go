@@ -0,0 +1,462 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+func newProfile(username, first, last, phoneE164 string) *models.UserProfile {
+	return &models.UserProfile{
+		AuthID:      primitive.NewObjectID(),
+		Username:    username,
+		UsernameKey: utils.UsernameKey(username),
+		FirstName:   first,
+		LastName:    last,
+		PhoneE164:   phoneE164,
+		Address:     models.Address{Country: "KR", City: "Seoul"},
+		Privacy:     models.DefaultPrivacySettings(),
+	}
+}
+
+func mustCreate(t *testing.T, repo *UserRepository, profile *models.UserProfile) *models.UserProfile {
+	t.Helper()
+	if err := repo.CreateProfile(context.Background(), profile); err != nil {
+		t.Fatalf("create %s: %v", profile.Username, err)
+	}
+	return profile
+}
+
+func TestCreateProfile(t *testing.T) {
+	seedAuthID := primitive.NewObjectID()
+
+	tests := []struct {
+		name    string
+		profile func() *models.UserProfile
+		wantErr error
+	}{
+		{
+			name:    "new profile",
+			profile: func() *models.UserProfile { return newProfile("polaris", "", "", "+821099998888") },
+		},
+		{
+			name: "same auth id",
+			profile: func() *models.UserProfile {
+				p := newProfile("polaris", "", "", "")
+				p.AuthID = seedAuthID
+				return p
+			},
+			wantErr: repository.ErrProfileExists,
+		},
+		{
+			name:    "same username",
+			profile: func() *models.UserProfile { return newProfile("kihyun", "", "", "") },
+			wantErr: repository.ErrUsernameTaken,
+		},
+		{
+			name:    "username differing only by case",
+			profile: func() *models.UserProfile { return newProfile("KiHyun", "", "", "") },
+			wantErr: repository.ErrUsernameTaken,
+		},
+		{
+			name:    "username with lookalike digit",
+			profile: func() *models.UserProfile { return newProfile("k1hyun", "", "", "") },
+			wantErr: repository.ErrUsernameTaken,
+		},
+		{
+			name:    "same phone number",
+			profile: func() *models.UserProfile { return newProfile("polaris", "", "", "+821012345678") },
+			wantErr: repository.ErrPhoneTaken,
+		},
+		{
+			name:    "profiles without phone numbers do not collide",
+			profile: func() *models.UserProfile { return newProfile("polaris", "", "", "") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewUserRepository()
+			seed := newProfile("kihyun", "Kihyun", "Kim", "+821012345678")
+			seed.AuthID = seedAuthID
+			mustCreate(t, repo, seed)
+			mustCreate(t, repo, newProfile("nophone", "", "", ""))
+
+			profile := tt.profile()
+			err := repo.CreateProfile(context.Background(), profile)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			stored, err := repo.GetProfileByID(context.Background(), profile.ID)
+			if err != nil || stored == nil {
+				t.Fatalf("stored profile not found: %v", err)
+			}
+			if stored.Status != models.StatusActive || stored.Version != 1 || stored.CreatedAt.IsZero() {
+				t.Errorf("stored = (%s, v%d, %v), want active v1 with created_at", stored.Status, stored.Version, stored.CreatedAt)
+			}
+		})
+	}
+}
+
+func TestGetProfileReturnsCopy(t *testing.T) {
+	repo := NewUserRepository()
+	profile := mustCreate(t, repo, newProfile("kihyun", "Kihyun", "Kim", ""))
+
+	got, _ := repo.GetProfileByUsername(context.Background(), "kihyun")
+	got.FirstName = "changed"
+	profile.LastName = "changed"
+
+	again, _ := repo.GetProfileByUsernameKey(context.Background(), utils.UsernameKey("KIHYUN"))
+	if again == nil || again.FirstName != "Kihyun" || again.LastName != "Kim" {
+		t.Errorf("stored profile shares memory with callers: %+v", again)
+	}
+
+	missing, err := repo.GetProfileByUsername(context.Background(), "nobody")
+	if missing != nil || err != nil {
+		t.Errorf("missing profile = (%v, %v), want (nil, nil)", missing, err)
+	}
+}
+
+func TestUpdateProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int64
+		update  repository.ProfileUpdate
+		wantErr error
+		check   func(t *testing.T, p *models.UserProfile)
+	}{
+		{
+			name:    "set nested field",
+			version: 1,
+			update:  repository.ProfileUpdate{Set: bson.M{"first_name": "Ki", "address.city": "Busan"}},
+			check: func(t *testing.T, p *models.UserProfile) {
+				if p.FirstName != "Ki" || p.Address.City != "Busan" || p.Address.Country != "KR" {
+					t.Errorf("got (%s, %s, %s)", p.FirstName, p.Address.City, p.Address.Country)
+				}
+			},
+		},
+		{
+			name:    "unset field",
+			version: 1,
+			update:  repository.ProfileUpdate{Unset: []string{"phone_e164"}},
+			check: func(t *testing.T, p *models.UserProfile) {
+				if p.PhoneE164 != "" {
+					t.Errorf("phone_e164 = %q, want unset", p.PhoneE164)
+				}
+			},
+		},
+		{
+			name:    "stale version",
+			version: 0,
+			update:  repository.ProfileUpdate{Set: bson.M{"first_name": "Ki"}},
+			wantErr: repository.ErrVersionConflict,
+		},
+		{
+			name:    "username of another profile",
+			version: 1,
+			update:  repository.ProfileUpdate{Set: bson.M{"username": "polaris"}},
+			wantErr: repository.ErrUsernameTaken,
+		},
+		{
+			name:    "username key of another profile",
+			version: 1,
+			update:  repository.ProfileUpdate{Set: bson.M{"username": "P0LARIS", "username_key": utils.UsernameKey("P0LARIS")}},
+			wantErr: repository.ErrUsernameTaken,
+		},
+		{
+			name:    "own username in another case",
+			version: 1,
+			update:  repository.ProfileUpdate{Set: bson.M{"username": "KiHyun", "username_key": utils.UsernameKey("KiHyun")}},
+			check: func(t *testing.T, p *models.UserProfile) {
+				if p.Username != "KiHyun" {
+					t.Errorf("username = %q", p.Username)
+				}
+			},
+		},
+		{
+			name:    "phone number of another profile",
+			version: 1,
+			update:  repository.ProfileUpdate{Set: bson.M{"phone_e164": "+821099998888"}},
+			wantErr: repository.ErrPhoneTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewUserRepository()
+			profile := mustCreate(t, repo, newProfile("kihyun", "Kihyun", "Kim", "+821012345678"))
+			mustCreate(t, repo, newProfile("polaris", "", "", "+821099998888"))
+
+			err := repo.UpdateProfile(context.Background(), profile.ID, tt.version, tt.update)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			stored, _ := repo.GetProfileByID(context.Background(), profile.ID)
+			if tt.wantErr != nil {
+				// 실패한 변경은 저장된 프로필에 반영되지 않아야 함
+				if stored.Version != 1 || stored.Username != "kihyun" || stored.PhoneE164 != "+821012345678" {
+					t.Errorf("failed update was applied: %+v", stored)
+				}
+				return
+			}
+			if stored.Version != 2 {
+				t.Errorf("version = %d, want 2", stored.Version)
+			}
+			tt.check(t, stored)
+		})
+	}
+
+	t.Run("missing profile", func(t *testing.T) {
+		repo := NewUserRepository()
+		err := repo.UpdateProfile(context.Background(), primitive.NewObjectID(), 1, repository.ProfileUpdate{Set: bson.M{"first_name": "Ki"}})
+		if !errors.Is(err, repository.ErrProfileNotFound) {
+			t.Errorf("err = %v, want %v", err, repository.ErrProfileNotFound)
+		}
+	})
+}
+
+func TestChangeStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int64
+		from    string
+		wantErr error
+	}{
+		{name: "applies", version: 1, from: models.StatusActive},
+		{name: "status changed by another request", version: 1, from: models.StatusSuspended, wantErr: repository.ErrStatusConflict},
+		{name: "profile changed by another request", version: 0, from: models.StatusActive, wantErr: repository.ErrVersionConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewUserRepository()
+			profile := mustCreate(t, repo, newProfile("kihyun", "", "", ""))
+
+			at := time.Now().UTC().Truncate(time.Millisecond)
+			err := repo.ChangeStatus(context.Background(), profile.ID, tt.version, &models.StatusChange{
+				From: tt.from, To: models.StatusBanned, Reason: "spam", ActorType: models.ActorAdmin, ActorID: "admin", At: at,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			stored, _ := repo.GetProfileByID(context.Background(), profile.ID)
+			if tt.wantErr != nil {
+				if stored.Status != models.StatusActive || len(stored.StatusHistory) != 0 {
+					t.Errorf("failed change was applied: %s, %d history entries", stored.Status, len(stored.StatusHistory))
+				}
+				return
+			}
+			if stored.Status != models.StatusBanned || stored.StatusReason != "spam" || stored.StatusChangedBy != "admin" ||
+				stored.StatusChangedAt == nil || !stored.StatusChangedAt.Equal(at) || stored.Version != 2 {
+				t.Errorf("stored = %+v", stored)
+			}
+			if len(stored.StatusHistory) != 1 || stored.StatusHistory[0].To != models.StatusBanned {
+				t.Errorf("history = %+v", stored.StatusHistory)
+			}
+		})
+	}
+}
+
+func TestChangeStatusTrimsHistory(t *testing.T) {
+	repo := NewUserRepository()
+	profile := mustCreate(t, repo, newProfile("kihyun", "", "", ""))
+
+	status := models.StatusActive
+	for i := 0; i < repository.MaxStatusHistory+3; i++ {
+		next := models.StatusInactive
+		if status == models.StatusInactive {
+			next = models.StatusActive
+		}
+		err := repo.ChangeStatus(context.Background(), profile.ID, int64(i+1), &models.StatusChange{
+			From: status, To: next, Reason: "toggle", At: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("change %d: %v", i, err)
+		}
+		status = next
+	}
+
+	stored, _ := repo.GetProfileByID(context.Background(), profile.ID)
+	if len(stored.StatusHistory) != repository.MaxStatusHistory {
+		t.Fatalf("history length = %d, want %d", len(stored.StatusHistory), repository.MaxStatusHistory)
+	}
+	if last := stored.StatusHistory[len(stored.StatusHistory)-1]; last.To != status {
+		t.Errorf("last history entry = %s, want the most recent change to %s", last.To, status)
+	}
+}
+
+func TestSearchProfiles(t *testing.T) {
+	repo := NewUserRepository()
+	kim := newProfile("kihyun", "Kihyun", "Kim", "")
+	private := newProfile("jdoe", "Jane", "Kim", "")
+	private.Privacy.ShowName = false
+	busan := newProfile("minsu", "Minsu", "Kim", "")
+	busan.Address.City = "Busan"
+	busan.Privacy.ShowCity = false
+	banned := newProfile("kimchi", "Kimchi", "Kim", "")
+	for _, p := range []*models.UserProfile{kim, private, busan, banned} {
+		mustCreate(t, repo, p)
+	}
+	if err := repo.ChangeStatus(context.Background(), banned.ID, 1, &models.StatusChange{From: models.StatusActive, To: models.StatusBanned, At: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		filter models.SearchFilter
+		want   []string
+	}{
+		{name: "shared last name", filter: models.SearchFilter{Query: "kim", Status: models.StatusActive}, want: []string{"jdoe", "kihyun", "minsu"}},
+		{name: "more matching terms rank first", filter: models.SearchFilter{Query: "kihyun kim", Status: models.StatusActive}, want: []string{"kihyun", "jdoe", "minsu"}},
+		{name: "case insensitive", filter: models.SearchFilter{Query: "KIHYUN", Status: models.StatusActive}, want: []string{"kihyun"}},
+		{name: "status filter", filter: models.SearchFilter{Query: "kimchi"}, want: []string{"kimchi"}},
+		{name: "inactive status excluded", filter: models.SearchFilter{Query: "kimchi", Status: models.StatusActive}, want: []string{}},
+		{name: "city filter", filter: models.SearchFilter{Query: "kim", Status: models.StatusActive, City: "busan"}, want: []string{"minsu"}},
+		{name: "private city is not filterable", filter: models.SearchFilter{Query: "kim", Status: models.StatusActive, City: "busan", PublicAddressOnly: true}, want: []string{}},
+		{name: "private name is not searchable", filter: models.SearchFilter{Query: "jane", Status: models.StatusActive, PublicNameOnly: true}, want: []string{}},
+		{name: "private name found by username", filter: models.SearchFilter{Query: "jdoe", Status: models.StatusActive, PublicNameOnly: true}, want: []string{"jdoe"}},
+		{name: "owner finds own private name", filter: models.SearchFilter{Query: "jane", Status: models.StatusActive, PublicNameOnly: true, OwnerAuthID: &private.AuthID}, want: []string{"jdoe"}},
+		{name: "partial words do not match", filter: models.SearchFilter{Query: "kih"}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, total, err := repo.SearchProfiles(context.Background(), tt.filter, models.SearchOptions{Limit: 10})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			for _, r := range results {
+				got = append(got, r.Profile.Username)
+			}
+			// 같은 점수는 _id 순서이므로 점수가 같은 결과끼리는 집합으로 비교
+			if !sameRanking(results, got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if total != int64(len(tt.want)) {
+				t.Errorf("total = %d, want %d", total, len(tt.want))
+			}
+		})
+	}
+}
+
+// sameRanking 점수가 같은 구간 안의 순서는 무시하고 결과 비교
+func sameRanking(results []models.ScoredProfile, got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for start := 0; start < len(results); {
+		end := start
+		for end < len(results) && results[end].Score == results[start].Score {
+			end++
+		}
+		seen := map[string]int{}
+		for _, name := range got[start:end] {
+			seen[name]++
+		}
+		for _, name := range want[start:end] {
+			seen[name]--
+		}
+		for _, n := range seen {
+			if n != 0 {
+				return false
+			}
+		}
+		start = end
+	}
+	return true
+}
+
+func TestSearchProfilesCursor(t *testing.T) {
+	repo := NewUserRepository()
+	for _, name := range []string{"alpha", "bravo", "charlie", "delta", "echo"} {
+		mustCreate(t, repo, newProfile(name, "Team", "Kim", ""))
+	}
+	filter := models.SearchFilter{Query: "team", Status: models.StatusActive}
+
+	all, total, err := repo.SearchProfiles(context.Background(), filter, models.SearchOptions{Limit: 10})
+	if err != nil || total != 5 || len(all) != 5 {
+		t.Fatalf("first search = (%d results, total %d, %v)", len(all), total, err)
+	}
+
+	// 두 개씩 넘기면 중복이나 누락 없이 같은 순서로 전부 조회
+	var paged []primitive.ObjectID
+	var after *models.SearchCursor
+	for page := 0; page < 4; page++ {
+		results, total, err := repo.SearchProfiles(context.Background(), filter, models.SearchOptions{Limit: 2, After: after})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total != 5 {
+			t.Errorf("page %d total = %d, want 5", page, total)
+		}
+		for _, r := range results {
+			paged = append(paged, r.Profile.ID)
+		}
+		if len(results) < 2 {
+			break
+		}
+		cursor := results[len(results)-1].Cursor()
+		after = &cursor
+	}
+
+	want := make([]primitive.ObjectID, len(all))
+	for i, r := range all {
+		want[i] = r.Profile.ID
+	}
+	if !reflect.DeepEqual(paged, want) {
+		t.Errorf("paged order %v, want %v", paged, want)
+	}
+}
+
+func TestListProfiles(t *testing.T) {
+	repo := NewUserRepository()
+	for _, name := range []string{"charlie", "alpha", "bravo"} {
+		mustCreate(t, repo, newProfile(name, "", "", ""))
+	}
+
+	tests := []struct {
+		name      string
+		filter    models.ProfileFilter
+		opts      models.ListOptions
+		want      []string
+		wantTotal int64
+	}{
+		{name: "sorted by username", opts: models.ListOptions{Page: 1, PageSize: 10, SortBy: "username"}, want: []string{"alpha", "bravo", "charlie"}, wantTotal: 3},
+		{name: "descending", opts: models.ListOptions{Page: 1, PageSize: 10, SortBy: "username", SortDesc: true}, want: []string{"charlie", "bravo", "alpha"}, wantTotal: 3},
+		{name: "second page", opts: models.ListOptions{Page: 2, PageSize: 2, SortBy: "username"}, want: []string{"charlie"}, wantTotal: 3},
+		{name: "past the last page", opts: models.ListOptions{Page: 5, PageSize: 2, SortBy: "username"}, want: []string{}, wantTotal: 3},
+		{name: "username filter", filter: models.ProfileFilter{Username: "RAV"}, opts: models.ListOptions{Page: 1, PageSize: 10}, want: []string{"bravo"}, wantTotal: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles, total, err := repo.ListProfiles(context.Background(), tt.filter, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			for _, p := range profiles {
+				got = append(got, p.Username)
+			}
+			if !reflect.DeepEqual(got, tt.want) || total != tt.wantTotal {
+				t.Errorf("got (%v, %d), want (%v, %d)", got, total, tt.want, tt.wantTotal)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+)
+
+var _ repository.VerificationRepository = (*VerificationRepository)(nil)
+
+// VerificationRepository 인메모리 전화번호 인증번호 발송 기록 저장소
+type VerificationRepository struct {
+	mu            sync.RWMutex
+	verifications map[primitive.ObjectID]*models.PhoneVerification
+	sends         map[string][]time.Time // 발송 한도 기록 (user:<auth_id>, phone:<E.164>별 발송 시각)
+}
+
+func NewVerificationRepository() *VerificationRepository {
+	return &VerificationRepository{
+		verifications: make(map[primitive.ObjectID]*models.PhoneVerification),
+		sends:         make(map[string][]time.Time),
+	}
+}
+
+func (r *VerificationRepository) CreateVerification(ctx context.Context, verification *models.PhoneVerification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if verification.ID.IsZero() {
+		verification.ID = primitive.NewObjectID()
+	}
+	r.verifications[verification.ID] = copyVerification(verification)
+	return nil
+}
+
+func (r *VerificationRepository) GetLatestVerification(ctx context.Context, authID primitive.ObjectID) (*models.PhoneVerification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var latest *models.PhoneVerification
+	for _, verification := range r.verifications {
+		if verification.AuthID != authID {
+			continue
+		}
+		if latest == nil || verification.CreatedAt.After(latest.CreatedAt) {
+			latest = verification
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+	return copyVerification(latest), nil
+}
+
+func (r *VerificationRepository) RecordAttempt(ctx context.Context, id primitive.ObjectID) (*models.PhoneVerification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	verification, ok := r.verifications[id]
+	if !ok {
+		return nil, repository.ErrVerificationNotFound
+	}
+	verification.Attempts++
+	return copyVerification(verification), nil
+}
+
+func (r *VerificationRepository) ConsumeVerification(ctx context.Context, id primitive.ObjectID, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	verification, ok := r.verifications[id]
+	if !ok || verification.ConsumedAt != nil {
+		return repository.ErrVerificationNotFound
+	}
+	verification.ConsumedAt = &at
+	return nil
+}
+
+func (r *VerificationRepository) ReserveSend(ctx context.Context, authID primitive.ObjectID, phoneE164 string, byUser, byPhone models.SendLimit, at time.Time) (bool, time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	userKey, phoneKey := "user:"+authID.Hex(), "phone:"+phoneE164
+	userSends, phoneSends := r.sends[userKey], r.sends[phoneKey]
+	if !byUser.Allows(userSends, at) || !byPhone.Allows(phoneSends, at) {
+		retryAt := byUser.RetryAt(userSends, at)
+		if phoneRetry := byPhone.RetryAt(phoneSends, at); phoneRetry.After(retryAt) {
+			retryAt = phoneRetry
+		}
+		return false, retryAt, nil
+	}
+	r.sends[userKey] = append(userSends, at)
+	r.sends[phoneKey] = append(phoneSends, at)
+	return true, time.Time{}, nil
+}
+
+func (r *VerificationRepository) DeleteVerificationsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.mu.Lock()
+	for key, sends := range r.sends {
+		if !sentSince(sends, cutoff) {
+			delete(r.sends, key)
+		}
+	}
+	r.mu.Unlock()
+
+	return r.deleteWhere(func(verification *models.PhoneVerification) bool {
+		return verification.CreatedAt.Before(cutoff)
+	}), nil
+}
+
+func (r *VerificationRepository) DeleteVerificationsByAuthID(ctx context.Context, authID primitive.ObjectID) (int64, error) {
+	r.mu.Lock()
+	delete(r.sends, "user:"+authID.Hex())
+	r.mu.Unlock()
+
+	return r.deleteWhere(func(verification *models.PhoneVerification) bool {
+		return verification.AuthID == authID
+	}), nil
+}
+func (r *VerificationRepository) deleteWhere(match func(*models.PhoneVerification) bool) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for id, verification := range r.verifications {
+		if match(verification) {
+			delete(r.verifications, id)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// sentSince cutoff 이후의 발송 시각이 있는지 확인
+func sentSince(sends []time.Time, cutoff time.Time) bool {
+	for _, sent := range sends {
+		if !sent.Before(cutoff) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyVerification 호출자가 저장된 기록을 변경하지 못하도록 복사
+func copyVerification(verification *models.PhoneVerification) *models.PhoneVerification {
+	copied := *verification
+	if verification.ConsumedAt != nil {
+		consumedAt := *verification.ConsumedAt
+		copied.ConsumedAt = &consumedAt
+	}
+	return &copied
+}
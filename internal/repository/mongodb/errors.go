@@ -0,0 +1,33 @@
+package mongodb
+
+import (
+	"errors"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/apperror"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+)
+
+// storageError 드라이버 에러 분류 (연결 끊김, 타임아웃은 apperror.Unavailable로 감쌈)
+func storageError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if mongo.IsNetworkError(err) || mongo.IsTimeout(err) || errors.Is(err, mongo.ErrClientDisconnected) {
+		return apperror.ServiceUnavailable(err)
+	}
+	return err
+}
+
+// duplicateProfileError 중복 키 에러를 위반한 유니크 인덱스에 맞는 에러로 변환
+func duplicateProfileError(err error) error {
+	if strings.Contains(err.Error(), "username_unique") || strings.Contains(err.Error(), "username_key_unique") {
+		return repository.ErrUsernameTaken
+	}
+	if strings.Contains(err.Error(), "phone_e164_unique") {
+		return repository.ErrPhoneTaken
+	}
+	return repository.ErrProfileExists
+}
@@ -0,0 +1,116 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+)
+
+var _ repository.ExportRepository = (*ExportRepository)(nil)
+
+type ExportRepository struct {
+	collection *mongo.Collection
+}
+
+func NewExportRepository(ctx context.Context, db *mongo.Database) (*ExportRepository, error) {
+	collection := db.Collection("user_exports")
+
+	report, err := EnsureIndexes(ctx, collection, exportIndexes)
+	if err != nil {
+		return nil, err
+	}
+	if len(report.Created) > 0 {
+		log.Printf("Created indexes on %s: %v", collection.Name(), report.Created)
+	}
+	if len(report.Extra) > 0 {
+		log.Printf("Warning: undeclared indexes on %s: %v", collection.Name(), report.Extra)
+	}
+
+	return &ExportRepository{collection: collection}, nil
+}
+
+func (r *ExportRepository) CreateExport(ctx context.Context, job *models.ExportJob) error {
+	if job.ID.IsZero() {
+		job.ID = primitive.NewObjectID()
+	}
+	_, err := r.collection.InsertOne(ctx, withActiveAuthID(job))
+	if mongo.IsDuplicateKeyError(err) {
+		return repository.ErrExportInProgress
+	}
+	return storageError(err)
+}
+
+func (r *ExportRepository) UpdateExport(ctx context.Context, job *models.ExportJob) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": job.ID}, withActiveAuthID(job))
+	if err != nil {
+		return storageError(err)
+	}
+	if result.MatchedCount == 0 {
+		return repository.ErrExportNotFound
+	}
+	return nil
+}
+
+func (r *ExportRepository) GetExport(ctx context.Context, id primitive.ObjectID) (*models.ExportJob, error) {
+	var job models.ExportJob
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&job)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, storageError(err)
+	}
+	return &job, nil
+}
+
+func (r *ExportRepository) AbandonStaleExports(ctx context.Context, authID primitive.ObjectID, before time.Time) error {
+	filter := bson.M{
+		"active_auth_id": authID,
+		"created_at":     bson.M{"$lt": before},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":       models.ExportFailed,
+			"error":        "export timed out",
+			"completed_at": time.Now(),
+		},
+		"$unset": bson.M{"active_auth_id": ""},
+	}
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	return storageError(err)
+}
+
+func (r *ExportRepository) DeleteExpiredExports(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.collection.DeleteMany(ctx, bson.M{"expires_at": bson.M{"$lte": now}})
+	if err != nil {
+		return 0, storageError(err)
+	}
+	return result.DeletedCount, nil
+}
+
+func (r *ExportRepository) DeleteExportsByAuthID(ctx context.Context, authID primitive.ObjectID) (int64, error) {
+	result, err := r.collection.DeleteMany(ctx, bson.M{"auth_id": authID})
+	if err != nil {
+		return 0, storageError(err)
+	}
+	return result.DeletedCount, nil
+}
+
+// withActiveAuthID 대기/진행 중 작업에만 active_auth_id를 채운 저장용 사본
+func withActiveAuthID(job *models.ExportJob) *models.ExportJob {
+	doc := *job
+	doc.ActiveAuthID = nil
+	if job.IsActive() {
+		authID := job.AuthID
+		doc.ActiveAuthID = &authID
+	}
+	return &doc
+}
@@ -0,0 +1,290 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IndexSpec 컬렉션에 존재해야 하는 인덱스 선언
+type IndexSpec struct {
+	Name   string
+	Keys   bson.D // 필드 순서가 의미를 가지므로 bson.D 사용, text 인덱스는 값으로 "text" 지정
+	Unique bool
+	Sparse bool // 필드가 있는 문서만 색인
+}
+
+// IndexReport 인덱스 동기화 결과
+type IndexReport struct {
+	Created   []string // 새로 생성된 인덱스
+	Unchanged []string // 선언과 일치하는 기존 인덱스
+	Extra     []string // 선언에 없는 기존 인덱스 (drift)
+}
+
+// userIndexes users 컬렉션 인덱스 선언
+var userIndexes = []IndexSpec{
+	{
+		Name: "profile_text",
+		Keys: bson.D{
+			{Key: "username", Value: "text"},
+			{Key: "first_name", Value: "text"},
+			{Key: "last_name", Value: "text"},
+		},
+	},
+	{
+		Name:   "auth_id_unique",
+		Keys:   bson.D{{Key: "auth_id", Value: 1}},
+		Unique: true,
+	},
+	{
+		Name:   "username_unique",
+		Keys:   bson.D{{Key: "username", Value: 1}},
+		Unique: true,
+	},
+	{
+		// 대소문자, 모양이 비슷한 글자만 다른 username 차단 (파기된 프로필은 키가 없어 색인하지 않음)
+		Name:   "username_key_unique",
+		Keys:   bson.D{{Key: "username_key", Value: 1}},
+		Unique: true,
+		Sparse: true,
+	},
+	{
+		// 전화번호가 없는 프로필은 색인하지 않아 여러 개 허용
+		Name:   "phone_e164_unique",
+		Keys:   bson.D{{Key: "phone_e164", Value: 1}},
+		Unique: true,
+		Sparse: true,
+	},
+	{
+		Name: "status",
+		Keys: bson.D{{Key: "status", Value: 1}},
+	},
+	{
+		Name: "created_at",
+		Keys: bson.D{{Key: "created_at", Value: -1}},
+	},
+	{
+		Name: "status_changed_at",
+		Keys: bson.D{{Key: "status", Value: 1}, {Key: "status_changed_at", Value: 1}},
+	},
+	{
+		// 자동완성 접두어 검색 (search_keys는 배열이므로 multikey 인덱스)
+		Name: "status_search_keys",
+		Keys: bson.D{{Key: "status", Value: 1}, {Key: "search_keys", Value: 1}},
+	},
+	{
+		// 주변 판매자 검색 ($geoNear), 좌표가 없는 문서는 색인하지 않음
+		Name: "address_location",
+		Keys: bson.D{{Key: "address.location", Value: "2dsphere"}},
+	},
+	{
+		Name:   "suspended_until",
+		Keys:   bson.D{{Key: "suspended_until", Value: 1}},
+		Sparse: true,
+	},
+}
+
+// exportIndexes user_exports 컬렉션 인덱스 선언
+var exportIndexes = []IndexSpec{
+	{
+		Name: "auth_id_created_at",
+		Keys: bson.D{{Key: "auth_id", Value: 1}, {Key: "created_at", Value: -1}},
+	},
+	{
+		// 사용자당 대기/진행 중 작업 하나 (완료, 실패한 작업에는 필드가 없음)
+		Name:   "active_auth_id_unique",
+		Keys:   bson.D{{Key: "active_auth_id", Value: 1}},
+		Unique: true,
+		Sparse: true,
+	},
+	{
+		Name:   "expires_at",
+		Keys:   bson.D{{Key: "expires_at", Value: 1}},
+		Sparse: true,
+	},
+}
+
+// verificationIndexes phone_verifications 컬렉션 인덱스 선언
+var verificationIndexes = []IndexSpec{
+	{
+		Name: "auth_id_created_at",
+		Keys: bson.D{{Key: "auth_id", Value: 1}, {Key: "created_at", Value: -1}},
+	},
+	{
+		Name: "created_at",
+		Keys: bson.D{{Key: "created_at", Value: 1}},
+	},
+}
+
+// existingIndex listIndexes 결과 문서
+type existingIndex struct {
+	Name    string `bson:"name"`
+	Key     bson.D `bson:"key"`
+	Unique  bool   `bson:"unique"`
+	Sparse  bool   `bson:"sparse"`
+	Weights bson.D `bson:"weights"`
+}
+
+// EnsureIndexes 선언된 인덱스가 컬렉션에 존재하도록 보장
+// 같은 이름 또는 같은 키의 인덱스가 다른 정의로 이미 존재하면 에러를 반환
+func EnsureIndexes(ctx context.Context, collection *mongo.Collection, specs []IndexSpec) (*IndexReport, error) {
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+	var existing []existingIndex
+	if err := cursor.All(ctx, &existing); err != nil {
+		return nil, fmt.Errorf("failed to decode indexes: %w", err)
+	}
+
+	report := &IndexReport{}
+	declared := make(map[string]bool, len(specs))
+	var missing []mongo.IndexModel
+
+	for _, spec := range specs {
+		declared[spec.Name] = true
+
+		match, err := findMatchingIndex(spec, existing)
+		if err != nil {
+			return nil, err
+		}
+		if match != nil {
+			report.Unchanged = append(report.Unchanged, spec.Name)
+			continue
+		}
+
+		opts := options.Index().SetName(spec.Name)
+		if spec.Unique {
+			opts.SetUnique(true)
+		}
+		if spec.Sparse {
+			opts.SetSparse(true)
+		}
+		missing = append(missing, mongo.IndexModel{Keys: spec.Keys, Options: opts})
+	}
+
+	for _, index := range existing {
+		if index.Name != "_id_" && !declared[index.Name] {
+			report.Extra = append(report.Extra, index.Name)
+		}
+	}
+
+	if len(missing) > 0 {
+		names, err := collection.Indexes().CreateMany(ctx, missing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create indexes on %s: %w", collection.Name(), err)
+		}
+		report.Created = names
+	}
+
+	return report, nil
+}
+
+// findMatchingIndex 선언과 일치하는 기존 인덱스 검색
+// 이름 또는 키가 겹치지만 정의가 다르면 충돌로 판단
+func findMatchingIndex(spec IndexSpec, existing []existingIndex) (*existingIndex, error) {
+	for i := range existing {
+		index := &existing[i]
+		sameName := index.Name == spec.Name
+		sameKeys := sameIndexKeys(spec, index)
+
+		// text 인덱스는 컬렉션당 하나만 허용됨
+		if !sameName && isTextSpec(spec) && isTextIndex(index) {
+			return nil, fmt.Errorf("index conflict: text index %q exists but %q is declared", index.Name, spec.Name)
+		}
+
+		switch {
+		case sameName && sameKeys && index.Unique == spec.Unique && index.Sparse == spec.Sparse:
+			return index, nil
+		case sameName:
+			return nil, fmt.Errorf("index conflict: %q exists with a different definition", spec.Name)
+		case sameKeys:
+			return nil, fmt.Errorf("index conflict: %q has the same keys as declared index %q", index.Name, spec.Name)
+		}
+	}
+	return nil, nil
+}
+
+// sameIndexKeys 선언된 키와 기존 인덱스 키 비교
+// text 인덱스는 키가 _fts/_ftsx로 저장되므로 weights 필드 목록으로 비교
+func sameIndexKeys(spec IndexSpec, index *existingIndex) bool {
+	if isTextSpec(spec) {
+		if !isTextIndex(index) {
+			return false
+		}
+		var want, got []string
+		for _, k := range spec.Keys {
+			if k.Value == "text" {
+				want = append(want, k.Key)
+			}
+		}
+		for _, w := range index.Weights {
+			if toInt64(w.Value) != 1 {
+				return false
+			}
+			got = append(got, w.Key)
+		}
+		sort.Strings(want)
+		sort.Strings(got)
+		return fmt.Sprint(want) == fmt.Sprint(got)
+	}
+
+	if len(spec.Keys) != len(index.Key) {
+		return false
+	}
+	for i, k := range spec.Keys {
+		e := index.Key[i]
+		if k.Key != e.Key || !sameKeyValue(k.Value, e.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func isTextSpec(spec IndexSpec) bool {
+	for _, k := range spec.Keys {
+		if k.Value == "text" {
+			return true
+		}
+	}
+	return false
+}
+
+func isTextIndex(index *existingIndex) bool {
+	for _, k := range index.Key {
+		if k.Key == "_fts" {
+			return true
+		}
+	}
+	return false
+}
+
+// sameKeyValue 인덱스 키 값 비교 (방향 숫자 또는 "2dsphere" 같은 타입 문자열)
+func sameKeyValue(a, b interface{}) bool {
+	as, aIsString := a.(string)
+	bs, bIsString := b.(string)
+	if aIsString || bIsString {
+		return aIsString && bIsString && as == bs
+	}
+	return toInt64(a) == toInt64(b)
+}
+
+// toInt64 인덱스 방향 값(int32, int64, double)을 정수로 통일
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int:
+		return int64(n)
+	case int32:
+		return int64(n)
+	case int64:
+		return n
+	case float64:
+		return int64(n)
+	default:
+		return 0
+	}
+}
@@ -3,6 +3,9 @@ package mongodb
 import (
 	"context"
 	"errors"
+	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -11,17 +14,21 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
 )
 
+var _ repository.UserRepository = (*UserRepository)(nil)
+
 type UserRepository struct {
 	db         *mongo.Database
 	collection *mongo.Collection
 }
 
-func NewUserRepository(mongoURI string) (*UserRepository, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+// DatabaseName 서비스가 사용하는 MongoDB 데이터베이스 이름
+const DatabaseName = "prisma_market"
 
+// Connect MongoDB 연결 후 서비스 데이터베이스 반환
+func Connect(ctx context.Context, mongoURI string) (*mongo.Database, error) {
 	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURI))
 	if err != nil {
 		return nil, err
@@ -31,9 +38,24 @@ func NewUserRepository(mongoURI string) (*UserRepository, error) {
 		return nil, err
 	}
 
-	db := client.Database("prisma_market")
+	return client.Database(DatabaseName), nil
+}
+
+func NewUserRepository(ctx context.Context, db *mongo.Database) (*UserRepository, error) {
 	collection := db.Collection("users")
 
+	// 인덱스 동기화 (충돌 시 시작 실패)
+	report, err := EnsureIndexes(ctx, collection, userIndexes)
+	if err != nil {
+		return nil, err
+	}
+	if len(report.Created) > 0 {
+		log.Printf("Created indexes on %s: %v", collection.Name(), report.Created)
+	}
+	if len(report.Extra) > 0 {
+		log.Printf("Warning: undeclared indexes on %s: %v", collection.Name(), report.Extra)
+	}
+
 	return &UserRepository{
 		db:         db,
 		collection: collection,
@@ -43,14 +65,15 @@ func NewUserRepository(mongoURI string) (*UserRepository, error) {
 func (r *UserRepository) CreateProfile(ctx context.Context, profile *models.UserProfile) error {
 	profile.CreatedAt = time.Now()
 	profile.UpdatedAt = time.Now()
-	profile.Status = "active"
+	profile.Status = models.StatusActive
+	profile.Version = 1
 
 	result, err := r.collection.InsertOne(ctx, profile)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
-			return errors.New("profile already exists")
+			return duplicateProfileError(err)
 		}
-		return err
+		return storageError(err)
 	}
 
 	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
@@ -67,7 +90,7 @@ func (r *UserRepository) GetProfileByID(ctx context.Context, id primitive.Object
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, nil
 		}
-		return nil, err
+		return nil, storageError(err)
 	}
 	return &profile, nil
 }
@@ -79,7 +102,7 @@ func (r *UserRepository) GetProfileByAuthID(ctx context.Context, authID primitiv
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, nil
 		}
-		return nil, err
+		return nil, storageError(err)
 	}
 	return &profile, nil
 }
@@ -91,64 +114,422 @@ func (r *UserRepository) GetProfileByUsername(ctx context.Context, username stri
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, nil
 		}
-		return nil, err
+		return nil, storageError(err)
 	}
 	return &profile, nil
 }
 
-func (r *UserRepository) UpdateProfile(ctx context.Context, id primitive.ObjectID, update bson.M) error {
-	update["updated_at"] = time.Now()
-	result, err := r.collection.UpdateByID(ctx, id, bson.M{"$set": update})
+func (r *UserRepository) GetProfileByUsernameKey(ctx context.Context, key string) (*models.UserProfile, error) {
+	var profile models.UserProfile
+	err := r.collection.FindOne(ctx, bson.M{"username_key": key}).Decode(&profile)
 	if err != nil {
-		return err
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, storageError(err)
+	}
+	return &profile, nil
+}
+
+func (r *UserRepository) GetProfileByPhone(ctx context.Context, e164 string) (*models.UserProfile, error) {
+	var profile models.UserProfile
+	err := r.collection.FindOne(ctx, bson.M{"phone_e164": e164}).Decode(&profile)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, storageError(err)
+	}
+	return &profile, nil
+}
+
+func (r *UserRepository) UpdateProfile(ctx context.Context, id primitive.ObjectID, version int64, update repository.ProfileUpdate) error {
+	set := bson.M{"updated_at": time.Now()}
+	for path, value := range update.Set {
+		set[path] = value
+	}
+	ops := bson.M{
+		"$set": set,
+		"$inc": bson.M{"version": 1},
+	}
+	if len(update.Unset) > 0 {
+		unset := bson.M{}
+		for _, path := range update.Unset {
+			unset[path] = ""
+		}
+		ops["$unset"] = unset
+	}
+
+	filter := bson.M{"_id": id, "version": versionQuery(version)}
+	result, err := r.collection.UpdateOne(ctx, filter, ops)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return duplicateProfileError(err)
+		}
+		return storageError(err)
 	}
 	if result.MatchedCount == 0 {
-		return errors.New("profile not found")
+		return r.missOrConflict(ctx, id, repository.ErrVersionConflict)
 	}
 	return nil
 }
 
-func (r *UserRepository) DeleteProfile(ctx context.Context, id primitive.ObjectID) error {
+// versionQuery 버전 조건 (version 필드가 없는 기존 문서는 버전 0으로 취급)
+func versionQuery(version int64) interface{} {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
+// missOrConflict 조건부 변경이 아무 문서에도 적용되지 않은 원인 판별
+func (r *UserRepository) missOrConflict(ctx context.Context, id primitive.ObjectID, conflict error) error {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": id})
+	if err != nil {
+		return storageError(err)
+	}
+	if count == 0 {
+		return repository.ErrProfileNotFound
+	}
+	return conflict
+}
+
+func (r *UserRepository) ChangeStatus(ctx context.Context, id primitive.ObjectID, version int64, change *models.StatusChange) error {
+	set := bson.M{
+		"status":            change.To,
+		"status_reason":     change.Reason,
+		"status_changed_at": change.At,
+		"status_changed_by": change.ActorID,
+		"updated_at":        change.At,
+	}
 	update := bson.M{
-		"$set": bson.M{
-			"status":     "inactive",
-			"updated_at": time.Now(),
+		"$set": set,
+		"$inc": bson.M{"version": 1},
+		"$push": bson.M{
+			"status_history": bson.M{
+				"$each":  []*models.StatusChange{change},
+				"$slice": -repository.MaxStatusHistory,
+			},
 		},
 	}
-	result, err := r.collection.UpdateByID(ctx, id, update)
+	if change.Until != nil {
+		set["suspended_until"] = change.Until
+	} else {
+		update["$unset"] = bson.M{"suspended_until": ""}
+	}
+
+	filter := bson.M{"_id": id, "status": change.From, "version": versionQuery(version)}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return storageError(err)
+	}
+	if result.MatchedCount == 0 {
+		// 상태가 그대로면 다른 필드 변경으로 버전만 바뀐 경우
+		count, err := r.collection.CountDocuments(ctx, bson.M{"_id": id, "status": change.From})
+		if err != nil {
+			return storageError(err)
+		}
+		if count > 0 {
+			return repository.ErrVersionConflict
+		}
+		return r.missOrConflict(ctx, id, repository.ErrStatusConflict)
+	}
+	return nil
+}
+
+func (r *UserRepository) ListExpiredSuspensions(ctx context.Context, now time.Time, limit int64) ([]*models.UserProfile, error) {
+	filter := bson.M{
+		"status":          models.StatusSuspended,
+		"suspended_until": bson.M{"$lte": now},
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetLimit(limit))
+	if err != nil {
+		return nil, storageError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var profiles []*models.UserProfile
+	if err = cursor.All(ctx, &profiles); err != nil {
+		return nil, storageError(err)
+	}
+	return profiles, nil
+}
+
+func (r *UserRepository) ListExpiredDeletions(ctx context.Context, cutoff time.Time, limit int64) ([]*models.UserProfile, error) {
+	filter := bson.M{
+		"status":            models.StatusInactive,
+		"status_changed_at": bson.M{"$lte": cutoff},
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetLimit(limit))
 	if err != nil {
-		return err
+		return nil, storageError(err)
+	}
+	defer cursor.Close(ctx)
+
+	var profiles []*models.UserProfile
+	if err = cursor.All(ctx, &profiles); err != nil {
+		return nil, storageError(err)
+	}
+	return profiles, nil
+}
+
+func (r *UserRepository) EraseProfile(ctx context.Context, id primitive.ObjectID, fromStatus string, tombstone *models.UserProfile) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": id, "status": fromStatus}, tombstone)
+	if err != nil {
+		return storageError(err)
 	}
 	if result.MatchedCount == 0 {
-		return errors.New("profile not found")
+		return r.missOrConflict(ctx, id, repository.ErrStatusConflict)
 	}
 	return nil
 }
 
-func (r *UserRepository) SearchProfiles(ctx context.Context, query string, limit int64) ([]*models.UserProfile, error) {
+func (r *UserRepository) SearchProfiles(ctx context.Context, filter models.SearchFilter, opts models.SearchOptions) ([]models.ScoredProfile, int64, error) {
+	match := searchFilterQuery(filter)
+
+	total, err := r.collection.CountDocuments(ctx, match, options.Count().SetLimit(repository.MaxSearchCount))
+	if err != nil {
+		return nil, 0, storageError(err)
+	}
+
+	// 점수를 필드로 꺼내 커서 조건과 정렬에 사용 (같은 점수는 _id로 순서 고정)
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$addFields", Value: bson.M{"search_score": bson.M{"$meta": "textScore"}}}},
+	}
+	if opts.After != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{
+			"$or": bson.A{
+				bson.M{"search_score": bson.M{"$lt": opts.After.Score}},
+				bson.M{"search_score": opts.After.Score, "_id": bson.M{"$gt": opts.After.ID}},
+			},
+		}}})
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "search_score", Value: -1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: opts.Limit}},
+	)
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, 0, storageError(err)
+	}
+	defer cursor.Close(ctx)
+
+	results := []models.ScoredProfile{}
+	for cursor.Next(ctx) {
+		var doc struct {
+			models.UserProfile `bson:",inline"`
+			Score              float64 `bson:"search_score"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, 0, err
+		}
+		profile := doc.UserProfile
+		results = append(results, models.ScoredProfile{Profile: &profile, Score: doc.Score})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, 0, storageError(err)
+	}
+
+	return results, total, nil
+}
+
+func (r *UserRepository) AutocompleteProfiles(ctx context.Context, prefix string, limit int64) ([]*models.UserProfile, error) {
+	// 앵커가 있는 대소문자 구분 정규식은 status_search_keys 인덱스 범위 스캔으로 처리됨
 	filter := bson.M{
+		"status":      models.StatusActive,
+		"search_keys": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(prefix)},
+	}
+	findOptions := options.Find().
+		SetProjection(bson.M{"username": 1, "first_name": 1, "last_name": 1, "privacy.show_name": 1}).
+		SetSort(bson.D{{Key: "username", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	defer cursor.Close(ctx)
+
+	profiles := []*models.UserProfile{}
+	if err = cursor.All(ctx, &profiles); err != nil {
+		return nil, storageError(err)
+	}
+	return profiles, nil
+}
+
+func (r *UserRepository) FindNearbyProfiles(ctx context.Context, query models.NearbyQuery) ([]models.NearbyProfile, error) {
+	// $geoNear는 address_location 2dsphere 인덱스를 사용하며 결과를 거리순으로 반환
+	pipeline := mongo.Pipeline{
+		{{Key: "$geoNear", Value: bson.M{
+			"near":          query.Point,
+			"key":           "address.location",
+			"distanceField": "distance",
+			"maxDistance":   query.RadiusMeters,
+			"spherical":     true,
+			"query": bson.M{
+				"status":                models.StatusActive,
+				"privacy.show_location": true,
+			},
+		}}},
+		{{Key: "$limit", Value: query.Limit}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	defer cursor.Close(ctx)
+
+	results := []models.NearbyProfile{}
+	for cursor.Next(ctx) {
+		var doc struct {
+			models.UserProfile `bson:",inline"`
+			Distance           float64 `bson:"distance"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		profile := doc.UserProfile
+		results = append(results, models.NearbyProfile{Profile: &profile, DistanceMeters: doc.Distance})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, storageError(err)
+	}
+	return results, nil
+}
+
+// searchFilterQuery 검색 조건을 MongoDB 쿼리로 변환
+func searchFilterQuery(filter models.SearchFilter) bson.M {
+	query := bson.M{
 		"$text": bson.M{
-			"$search": query,
+			"$search": filter.Query,
 		},
-		"status": "active",
 	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.City != "" {
+		query["address.city"] = exactFold(filter.City)
+		if filter.PublicAddressOnly {
+			query["privacy.show_city"] = true
+		}
+	}
+	if filter.Country != "" {
+		query["address.country"] = exactFold(filter.Country)
+		if filter.PublicAddressOnly {
+			query["privacy.show_country"] = true
+		}
+	}
+	if filter.PublicNameOnly {
+		// text 인덱스는 어느 필드가 일치했는지 알려주지 않으므로 username 포함 여부를 따로 확인
+		visible := bson.A{
+			bson.M{"privacy.show_name": true},
+			bson.M{"username": anyTermFold(filter.Query)},
+		}
+		if filter.OwnerAuthID != nil {
+			visible = append(visible, bson.M{"auth_id": *filter.OwnerAuthID})
+		}
+		query["$or"] = visible
+	}
+	return query
+}
 
+// anyTermFold 검색어 중 하나라도 포함하는지 대소문자를 무시해 확인하는 조건
+func anyTermFold(query string) primitive.Regex {
+	terms := strings.Fields(query)
+	for i, term := range terms {
+		terms[i] = regexp.QuoteMeta(term)
+	}
+	return primitive.Regex{Pattern: strings.Join(terms, "|"), Options: "i"}
+}
+
+// exactFold 대소문자를 무시한 전체 일치 조건
+func exactFold(value string) primitive.Regex {
+	return primitive.Regex{Pattern: "^" + regexp.QuoteMeta(value) + "$", Options: "i"}
+}
+
+func (r *UserRepository) ListProfiles(ctx context.Context, filter models.ProfileFilter, opts models.ListOptions) ([]*models.UserProfile, int64, error) {
+	query := profileFilterQuery(filter)
+
+	total, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, storageError(err)
+	}
+
+	direction := 1
+	if opts.SortDesc {
+		direction = -1
+	}
 	findOptions := options.Find().
-		SetLimit(limit).
-		SetSort(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}})
+		SetSkip(opts.Skip()).
+		SetLimit(opts.PageSize).
+		SetSort(bson.D{{Key: opts.SortBy, Value: direction}, {Key: "_id", Value: direction}})
 
-	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	cursor, err := r.collection.Find(ctx, query, findOptions)
 	if err != nil {
-		return nil, err
+		return nil, 0, storageError(err)
 	}
 	defer cursor.Close(ctx)
 
-	var profiles []*models.UserProfile
+	profiles := []*models.UserProfile{}
 	if err = cursor.All(ctx, &profiles); err != nil {
-		return nil, err
+		return nil, 0, storageError(err)
 	}
 
-	return profiles, nil
+	return profiles, total, nil
+}
+
+func (r *UserRepository) ScanProfiles(ctx context.Context, filter models.ProfileFilter, fn func(*models.UserProfile) error) error {
+	cursor, err := r.collection.Find(ctx, profileFilterQuery(filter), options.Find().SetBatchSize(500))
+	if err != nil {
+		return storageError(err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var profile models.UserProfile
+		if err := cursor.Decode(&profile); err != nil {
+			return err
+		}
+		if err := fn(&profile); err != nil {
+			return err
+		}
+	}
+	return storageError(cursor.Err())
+}
+
+// profileFilterQuery 관리자 목록 필터를 MongoDB 쿼리로 변환
+func profileFilterQuery(filter models.ProfileFilter) bson.M {
+	query := bson.M{}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.Country != "" {
+		query["address.country"] = exactFold(filter.Country)
+	}
+	if filter.Email != "" {
+		query["email"] = primitive.Regex{Pattern: regexp.QuoteMeta(filter.Email), Options: "i"}
+	}
+	if filter.Username != "" {
+		query["username"] = primitive.Regex{Pattern: regexp.QuoteMeta(filter.Username), Options: "i"}
+	}
+
+	created := bson.M{}
+	if filter.CreatedFrom != nil {
+		created["$gte"] = *filter.CreatedFrom
+	}
+	if filter.CreatedTo != nil {
+		created["$lt"] = *filter.CreatedTo
+	}
+	if len(created) > 0 {
+		query["created_at"] = created
+	}
+
+	return query
 }
 
 func (r *UserRepository) Close(ctx context.Context) error {
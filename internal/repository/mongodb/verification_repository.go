@@ -0,0 +1,183 @@
+package mongodb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+)
+
+var _ repository.VerificationRepository = (*VerificationRepository)(nil)
+
+type VerificationRepository struct {
+	collection *mongo.Collection
+	limits     *mongo.Collection // 사용자, 전화번호별 발송 시각 (발송 한도 예약용)
+}
+
+// sendLimitDoc 발송 한도 문서 (_id는 user:<auth_id> 또는 phone:<전화번호 해시>)
+type sendLimitDoc struct {
+	ID    string      `bson:"_id"`
+	Sends []time.Time `bson:"sends"`
+}
+
+func NewVerificationRepository(ctx context.Context, db *mongo.Database) (*VerificationRepository, error) {
+	collection := db.Collection("phone_verifications")
+
+	report, err := EnsureIndexes(ctx, collection, verificationIndexes)
+	if err != nil {
+		return nil, err
+	}
+	if len(report.Created) > 0 {
+		log.Printf("Created indexes on %s: %v", collection.Name(), report.Created)
+	}
+	if len(report.Extra) > 0 {
+		log.Printf("Warning: undeclared indexes on %s: %v", collection.Name(), report.Extra)
+	}
+
+	return &VerificationRepository{collection: collection, limits: db.Collection("phone_verification_limits")}, nil
+}
+
+func (r *VerificationRepository) CreateVerification(ctx context.Context, verification *models.PhoneVerification) error {
+	if verification.ID.IsZero() {
+		verification.ID = primitive.NewObjectID()
+	}
+	_, err := r.collection.InsertOne(ctx, verification)
+	return storageError(err)
+}
+
+func (r *VerificationRepository) GetLatestVerification(ctx context.Context, authID primitive.ObjectID) (*models.PhoneVerification, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	var verification models.PhoneVerification
+	err := r.collection.FindOne(ctx, bson.M{"auth_id": authID}, opts).Decode(&verification)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, storageError(err)
+	}
+	return &verification, nil
+}
+
+func (r *VerificationRepository) RecordAttempt(ctx context.Context, id primitive.ObjectID) (*models.PhoneVerification, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var verification models.PhoneVerification
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, bson.M{"$inc": bson.M{"attempts": 1}}, opts).Decode(&verification)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.ErrVerificationNotFound
+		}
+		return nil, storageError(err)
+	}
+	return &verification, nil
+}
+
+func (r *VerificationRepository) ConsumeVerification(ctx context.Context, id primitive.ObjectID, at time.Time) error {
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": id, "consumed_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"consumed_at": at}},
+	)
+	if err != nil {
+		return storageError(err)
+	}
+	if result.MatchedCount == 0 {
+		return repository.ErrVerificationNotFound
+	}
+	return nil
+}
+
+// ReserveSend 사용자 한도, 전화번호 한도 순으로 예약하고 전화번호 한도에 걸리면 사용자 예약을 취소
+func (r *VerificationRepository) ReserveSend(ctx context.Context, authID primitive.ObjectID, phoneE164 string, byUser, byPhone models.SendLimit, at time.Time) (bool, time.Time, error) {
+	userKey := userLimitKey(authID)
+	reserved, retryAt, err := r.reserve(ctx, userKey, byUser, at)
+	if err != nil || !reserved {
+		return reserved, retryAt, err
+	}
+	reserved, retryAt, err = r.reserve(ctx, phoneLimitKey(phoneE164), byPhone, at)
+	if err != nil || !reserved {
+		if _, releaseErr := r.limits.UpdateOne(ctx, bson.M{"_id": userKey}, bson.M{"$pull": bson.M{"sends": at}}); releaseErr != nil {
+			log.Printf("Failed to release SMS send reservation %s: %v", userKey, releaseErr)
+		}
+	}
+	return reserved, retryAt, err
+}
+
+// reserve 한도 조건을 filter에 넣은 upsert로 발송 시각을 추가
+// 문서가 있는데 조건에 맞지 않으면 같은 _id로 삽입을 시도해 중복 키 에러가 나므로 한도에 걸린 것으로 처리
+func (r *VerificationRepository) reserve(ctx context.Context, key string, limit models.SendLimit, at time.Time) (bool, time.Time, error) {
+	recent := bson.M{"$filter": bson.M{
+		"input": bson.M{"$ifNull": bson.A{"$sends", bson.A{}}},
+		"cond":  bson.M{"$gt": bson.A{"$$this", at.Add(-limit.Window)}},
+	}}
+	conditions := bson.A{bson.M{"$lt": bson.A{bson.M{"$size": recent}, limit.Max}}}
+	if limit.Interval > 0 {
+		// 빈 배열의 $max는 null이므로 가장 이른 시각으로 대체
+		latest := bson.M{"$ifNull": bson.A{bson.M{"$max": "$sends"}, time.Time{}}}
+		conditions = append(conditions, bson.M{"$lte": bson.A{latest, at.Add(-limit.Interval)}})
+	}
+
+	filter := bson.M{"_id": key, "$expr": bson.M{"$and": conditions}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{"sends": bson.M{"$concatArrays": bson.A{recent, bson.A{at}}}}}}}
+	for attempt := 0; ; attempt++ {
+		_, err := r.limits.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+		if err == nil {
+			return true, time.Time{}, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return false, time.Time{}, storageError(err)
+		}
+
+		var doc sendLimitDoc
+		if err := r.limits.FindOne(ctx, bson.M{"_id": key}).Decode(&doc); err != nil {
+			return false, time.Time{}, storageError(err)
+		}
+		// 첫 문서를 동시에 만들다 중복 키가 난 경우는 한도 안이면 한 번 더 시도
+		if attempt > 0 || !limit.Allows(doc.Sends, at) {
+			return false, limit.RetryAt(doc.Sends, at), nil
+		}
+	}
+}
+
+func (r *VerificationRepository) DeleteVerificationsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	// cutoff 이후 발송이 하나도 없는 한도 문서 삭제
+	if _, err := r.limits.DeleteMany(ctx, bson.M{"sends": bson.M{"$not": bson.M{"$gte": cutoff}}}); err != nil {
+		return 0, storageError(err)
+	}
+	result, err := r.collection.DeleteMany(ctx, bson.M{"created_at": bson.M{"$lt": cutoff}})
+	if err != nil {
+		return 0, storageError(err)
+	}
+	return result.DeletedCount, nil
+}
+
+func (r *VerificationRepository) DeleteVerificationsByAuthID(ctx context.Context, authID primitive.ObjectID) (int64, error) {
+	if _, err := r.limits.DeleteOne(ctx, bson.M{"_id": userLimitKey(authID)}); err != nil {
+		return 0, storageError(err)
+	}
+	result, err := r.collection.DeleteMany(ctx, bson.M{"auth_id": authID})
+	if err != nil {
+		return 0, storageError(err)
+	}
+	return result.DeletedCount, nil
+}
+
+func userLimitKey(authID primitive.ObjectID) string {
+	return "user:" + authID.Hex()
+}
+
+// phoneLimitKey 전화번호 한도 문서 키 (프로필을 파기해도 보관 기간 동안 남으므로 번호 대신 해시 사용)
+func phoneLimitKey(phoneE164 string) string {
+	sum := sha256.Sum256([]byte(phoneE164))
+	return "phone:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/apperror"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+var (
+	ErrProfileExists   = apperror.New(apperror.Conflict, "profile_exists", "profile already exists") // 같은 auth_id의 프로필이 이미 있음
+	ErrUsernameTaken   = apperror.New(apperror.Conflict, "username_taken", "username already exists")
+	ErrPhoneTaken      = apperror.New(apperror.Conflict, "phone_number_taken", "phone number is already registered")
+	ErrProfileNotFound = apperror.New(apperror.NotFound, "profile_not_found", "profile not found")
+	ErrStatusConflict  = apperror.New(apperror.Conflict, "status_conflict", "profile status was changed concurrently")
+	ErrVersionConflict = apperror.New(apperror.Conflict, "version_conflict", "profile was modified concurrently")
+)
+
+// MaxStatusHistory 프로필 문서에 보관하는 상태 변경 이력 최대 개수
+const MaxStatusHistory = 100
+
+// MaxSearchCount 검색 결과 전체 개수 집계 한도 (넘으면 한도 값 반환)
+const MaxSearchCount = 1000
+
+// ProfileUpdate 프로필 부분 변경 (경로는 "address.city" 같은 점 표기 사용)
+type ProfileUpdate struct {
+	Set   bson.M   // 값을 설정할 필드
+	Unset []string // 제거할 필드
+}
+
+// UserRepository 사용자 프로필 저장소 인터페이스
+// 조회 메서드는 프로필이 없으면 (nil, nil)을 반환하며, 모든 변경 메서드는 프로필 버전을 1 증가
+type UserRepository interface {
+	CreateProfile(ctx context.Context, profile *models.UserProfile) error
+	GetProfileByID(ctx context.Context, id primitive.ObjectID) (*models.UserProfile, error)
+	GetProfileByAuthID(ctx context.Context, authID primitive.ObjectID) (*models.UserProfile, error)
+	GetProfileByUsername(ctx context.Context, username string) (*models.UserProfile, error)
+	// GetProfileByUsernameKey 정규화된 username 키(utils.UsernameKey)로 프로필 조회
+	GetProfileByUsernameKey(ctx context.Context, key string) (*models.UserProfile, error)
+	// GetProfileByPhone 정규화된 전화번호(E.164)로 프로필 조회
+	GetProfileByPhone(ctx context.Context, e164 string) (*models.UserProfile, error)
+	// UpdateProfile 현재 버전이 version일 때만 update를 적용하고 버전을 1 증가
+	// 다른 요청이 먼저 프로필을 바꿨으면 ErrVersionConflict 반환
+	UpdateProfile(ctx context.Context, id primitive.ObjectID, version int64, update ProfileUpdate) error
+	// ChangeStatus 현재 상태가 change.From이고 버전이 version일 때만 상태를 변경하고 이력을 추가 (버전 1 증가)
+	// 다른 요청이 먼저 상태를 바꿨으면 ErrStatusConflict, 다른 필드를 바꿨으면 ErrVersionConflict 반환
+	ChangeStatus(ctx context.Context, id primitive.ObjectID, version int64, change *models.StatusChange) error
+	// ListExpiredSuspensions 정지 해제 시각이 지난 suspended 프로필 조회
+	ListExpiredSuspensions(ctx context.Context, now time.Time, limit int64) ([]*models.UserProfile, error)
+	// ListExpiredDeletions cutoff 이전에 탈퇴한(inactive) 프로필 조회
+	ListExpiredDeletions(ctx context.Context, cutoff time.Time, limit int64) ([]*models.UserProfile, error)
+	// EraseProfile 현재 상태가 fromStatus일 때만 문서를 tombstone으로 교체
+	EraseProfile(ctx context.Context, id primitive.ObjectID, fromStatus string, tombstone *models.UserProfile) error
+	// SearchProfiles 검색 점수 순으로 opts.After 다음 결과를 최대 opts.Limit개 반환하고,
+	// 전체 결과 수는 MaxSearchCount까지만 집계
+	SearchProfiles(ctx context.Context, filter models.SearchFilter, opts models.SearchOptions) ([]models.ScoredProfile, int64, error)
+	// AutocompleteProfiles 검색 키(models.BuildSearchKeys)가 prefix로 시작하는 active 프로필을
+	// username 순으로 최대 limit개 반환 (자동완성 결과에 필요한 필드만 채움)
+	AutocompleteProfiles(ctx context.Context, prefix string, limit int64) ([]*models.UserProfile, error)
+	// FindNearbyProfiles 기준 지점에서 반경 안에 있고 위치 공개 설정된 active 프로필을 가까운 순으로 최대 Limit개 반환
+	FindNearbyProfiles(ctx context.Context, query models.NearbyQuery) ([]models.NearbyProfile, error)
+	// ListProfiles 필터에 맞는 프로필 한 페이지와 전체 개수 반환
+	ListProfiles(ctx context.Context, filter models.ProfileFilter, opts models.ListOptions) ([]*models.UserProfile, int64, error)
+	// ScanProfiles 필터에 맞는 프로필을 하나씩 fn에 전달 (fn이 에러를 반환하면 중단하고 그 에러 반환)
+	ScanProfiles(ctx context.Context, filter models.ProfileFilter, fn func(*models.UserProfile) error) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/apperror"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+var ErrVerificationNotFound = apperror.New(apperror.NotFound, "verification_not_found", "no pending phone verification")
+
+// VerificationRepository 전화번호 인증번호 발송 기록 저장소 인터페이스
+// 조회 메서드는 기록이 없으면 (nil, nil)을 반환
+type VerificationRepository interface {
+	CreateVerification(ctx context.Context, verification *models.PhoneVerification) error
+	// GetLatestVerification 사용자에게 가장 최근에 발송한 인증번호 기록
+	GetLatestVerification(ctx context.Context, authID primitive.ObjectID) (*models.PhoneVerification, error)
+	// RecordAttempt 확인 시도 횟수를 1 증가시키고 변경된 기록 반환 (동시 확인 요청도 모두 집계)
+	RecordAttempt(ctx context.Context, id primitive.ObjectID) (*models.PhoneVerification, error)
+	// ConsumeVerification 아직 사용하지 않은 기록만 사용 처리 (이미 사용했으면 ErrVerificationNotFound)
+	ConsumeVerification(ctx context.Context, id primitive.ObjectID, at time.Time) error
+	// ReserveSend 사용자와 전화번호별 발송 한도 안에서만 at 시각의 발송을 기록 (동시 요청이 함께 한도를 넘지 않도록 원자적으로 처리)
+	// 한도에 걸리면 false와 다시 발송할 수 있는 시각 반환
+	ReserveSend(ctx context.Context, authID primitive.ObjectID, phoneE164 string, byUser, byPhone models.SendLimit, at time.Time) (bool, time.Time, error)
+	// DeleteVerificationsBefore cutoff 이전에 발송한 기록(발송 한도 기록 포함) 삭제 후 삭제한 인증번호 기록 개수 반환
+	DeleteVerificationsBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	DeleteVerificationsByAuthID(ctx context.Context, authID primitive.ObjectID) (int64, error)
+}
@@ -0,0 +1,366 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+const (
+	// MinScore 결과에 포함할 최소 유사도 (0~1)
+	MinScore = 0.3
+
+	nameWeight = 0.9 // 이름 필드 점수 가중치 (username 일치를 우선)
+)
+
+// Index 프로필 오타 허용 검색용 트라이그램 인덱스
+// active 프로필의 username과 공개된 이름만 색인하며, 모든 메서드는 동시 호출에 안전
+type Index struct {
+	mu       sync.RWMutex
+	docs     map[primitive.ObjectID]*document
+	postings map[string]map[primitive.ObjectID]struct{} // 트라이그램 → 문서
+	builtAt  time.Time
+
+	rebuildMu sync.Mutex
+	dirty     map[primitive.ObjectID]struct{} // 재구성 중 변경된 문서 (nil이면 재구성 중 아님)
+}
+
+// Hit 검색 결과
+type Hit struct {
+	ID         primitive.ObjectID
+	Username   string
+	FirstName  string // 이름 공개 설정일 때만 채움
+	LastName   string
+	Score      float64
+	Highlights []models.Highlight
+}
+
+// Stats 인덱스 상태
+type Stats struct {
+	Documents int
+	Trigrams  int
+	BuiltAt   time.Time // 마지막 전체 재구성 시각 (재구성 전이면 zero)
+}
+
+// ScanFunc 색인할 프로필을 fn으로 차례로 전달하는 함수 (저장소 전체 조회)
+type ScanFunc func(ctx context.Context, fn func(*models.UserProfile) error) error
+
+type document struct {
+	id        primitive.ObjectID
+	username  string
+	firstName string
+	lastName  string
+	fields    []field
+	grams     map[string]struct{}
+}
+
+type field struct {
+	name  string
+	value string
+	words []word
+}
+
+// word 필드 값의 단어 (start, end는 원래 값 기준 rune 위치)
+type word struct {
+	text  string
+	start int
+	end   int
+	grams map[string]struct{}
+}
+
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[primitive.ObjectID]*document),
+		postings: make(map[string]map[primitive.ObjectID]struct{}),
+	}
+}
+
+// Put 프로필 색인 (active가 아니면 인덱스에서 제거)
+func (ix *Index) Put(profile *models.UserProfile) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.markDirty(profile.ID)
+	ix.remove(profile.ID)
+	if profile.Status == models.StatusActive {
+		ix.add(newDocument(profile))
+	}
+}
+
+// Remove 프로필을 인덱스에서 제거
+func (ix *Index) Remove(id primitive.ObjectID) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.markDirty(id)
+	ix.remove(id)
+}
+
+// Rebuild scan으로 읽은 프로필로 새 인덱스를 만든 뒤 교체
+// 재구성 중에 Put/Remove된 프로필은 교체 시점의 현재 상태를 유지
+func (ix *Index) Rebuild(ctx context.Context, scan ScanFunc) (Stats, error) {
+	ix.rebuildMu.Lock()
+	defer ix.rebuildMu.Unlock()
+
+	ix.mu.Lock()
+	ix.dirty = make(map[primitive.ObjectID]struct{})
+	ix.mu.Unlock()
+
+	fresh := NewIndex()
+	err := scan(ctx, func(profile *models.UserProfile) error {
+		if profile.Status == models.StatusActive {
+			fresh.add(newDocument(profile))
+		}
+		return nil
+	})
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	dirty := ix.dirty
+	ix.dirty = nil
+	if err != nil {
+		return ix.stats(), err
+	}
+
+	for id := range dirty {
+		fresh.remove(id)
+		if doc, ok := ix.docs[id]; ok {
+			fresh.add(doc)
+		}
+	}
+	ix.docs = fresh.docs
+	ix.postings = fresh.postings
+	ix.builtAt = time.Now()
+	return ix.stats(), nil
+}
+
+// Stats 인덱스 상태 조회
+func (ix *Index) Stats() Stats {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return ix.stats()
+}
+
+func (ix *Index) stats() Stats {
+	return Stats{Documents: len(ix.docs), Trigrams: len(ix.postings), BuiltAt: ix.builtAt}
+}
+
+// Search 검색어와 비슷한 프로필을 점수 순으로 최대 limit개 반환
+// 검색어의 단어마다 가장 비슷한 단어의 점수를 구해 평균하며, 접두어 일치는 가산점
+func (ix *Index) Search(query string, limit int) []Hit {
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		return []Hit{}
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	// 트라이그램을 하나라도 공유하는 문서만 후보로 점수 계산
+	candidates := make(map[primitive.ObjectID]struct{})
+	for _, term := range terms {
+		for gram := range term.grams {
+			for id := range ix.postings[gram] {
+				candidates[id] = struct{}{}
+			}
+		}
+	}
+
+	hits := []Hit{}
+	for id := range candidates {
+		if hit, ok := ix.docs[id].match(terms); ok {
+			hits = append(hits, hit)
+		}
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Username < hits[j].Username
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+func (ix *Index) markDirty(id primitive.ObjectID) {
+	if ix.dirty != nil {
+		ix.dirty[id] = struct{}{}
+	}
+}
+
+func (ix *Index) add(doc *document) {
+	ix.docs[doc.id] = doc
+	for gram := range doc.grams {
+		ids, ok := ix.postings[gram]
+		if !ok {
+			ids = make(map[primitive.ObjectID]struct{})
+			ix.postings[gram] = ids
+		}
+		ids[doc.id] = struct{}{}
+	}
+}
+
+func (ix *Index) remove(id primitive.ObjectID) {
+	doc, ok := ix.docs[id]
+	if !ok {
+		return
+	}
+	delete(ix.docs, id)
+	for gram := range doc.grams {
+		ids := ix.postings[gram]
+		delete(ids, id)
+		if len(ids) == 0 {
+			delete(ix.postings, gram)
+		}
+	}
+}
+
+// newDocument 프로필의 공개 필드로 색인 문서 생성 (이름 비공개면 username만 색인)
+func newDocument(profile *models.UserProfile) *document {
+	doc := &document{
+		id:       profile.ID,
+		username: profile.Username,
+		grams:    make(map[string]struct{}),
+	}
+	values := []struct{ name, value string }{{"username", profile.Username}}
+	if profile.Privacy.ShowName {
+		doc.firstName = profile.FirstName
+		doc.lastName = profile.LastName
+		values = append(values,
+			struct{ name, value string }{"first_name", profile.FirstName},
+			struct{ name, value string }{"last_name", profile.LastName},
+		)
+	}
+
+	for _, v := range values {
+		words := tokenize(v.value)
+		if len(words) == 0 {
+			continue
+		}
+		doc.fields = append(doc.fields, field{name: v.name, value: v.value, words: words})
+		for _, w := range words {
+			for gram := range w.grams {
+				doc.grams[gram] = struct{}{}
+			}
+		}
+	}
+	return doc
+}
+
+// match 문서 점수와 일치한 단어 위치 계산
+func (doc *document) match(terms []word) (Hit, bool) {
+	total := 0.0
+	matched := make([][]bool, len(doc.fields))
+	for i, f := range doc.fields {
+		matched[i] = make([]bool, len(f.words))
+	}
+
+	for _, term := range terms {
+		best := 0.0
+		for i, f := range doc.fields {
+			weight := 1.0
+			if f.name != "username" {
+				weight = nameWeight
+			}
+			for j, w := range f.words {
+				score := wordScore(term, w)
+				if score >= MinScore {
+					matched[i][j] = true
+				}
+				if score*weight > best {
+					best = score * weight
+				}
+			}
+		}
+		total += best
+	}
+
+	score := total / float64(len(terms))
+	if score < MinScore {
+		return Hit{}, false
+	}
+
+	hit := Hit{
+		ID:        doc.id,
+		Username:  doc.username,
+		FirstName: doc.firstName,
+		LastName:  doc.lastName,
+		Score:     score,
+	}
+	for i, f := range doc.fields {
+		var spans []models.Span
+		for j, w := range f.words {
+			if matched[i][j] {
+				spans = append(spans, models.Span{Start: w.start, End: w.end})
+			}
+		}
+		if len(spans) > 0 {
+			hit.Highlights = append(hit.Highlights, models.Highlight{Field: f.name, Value: f.value, Spans: spans})
+		}
+	}
+	return hit, true
+}
+
+// wordScore 두 단어의 트라이그램 유사도 (공유 트라이그램 / 전체 트라이그램)
+// 검색어가 단어의 접두어이면 입력 중인 검색어로 보고 길이 비율에 따라 가산
+func wordScore(term, w word) float64 {
+	if term.text == w.text {
+		return 1
+	}
+	shared := 0
+	for gram := range term.grams {
+		if _, ok := w.grams[gram]; ok {
+			shared++
+		}
+	}
+	score := float64(shared) / float64(len(term.grams)+len(w.grams)-shared)
+
+	if len(term.text) < len(w.text) && w.text[:len(term.text)] == term.text {
+		ratio := float64(utf8.RuneCountInString(term.text)) / float64(utf8.RuneCountInString(w.text))
+		if prefix := 0.6 + 0.4*ratio; prefix > score {
+			score = prefix
+		}
+	}
+	return score
+}
+
+// tokenize 문자/숫자 연속 구간을 단어로 분리해 접어진(FoldSearchKey) 형태와 트라이그램 계산
+func tokenize(value string) []word {
+	var words []word
+	runes := []rune(value)
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		inWord := i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsNumber(runes[i]) || unicode.Is(unicode.Mn, runes[i]))
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			if text := utils.FoldSearchKey(string(runes[start:i])); text != "" {
+				words = append(words, word{text: text, start: start, end: i, grams: trigrams(text)})
+			}
+			start = -1
+		}
+	}
+	return words
+}
+
+// trigrams 단어 앞에 공백 두 개, 뒤에 공백 하나를 붙여 만든 3글자 조각 집합
+func trigrams(text string) map[string]struct{} {
+	padded := append([]rune("  "+text), ' ')
+	grams := make(map[string]struct{}, len(padded)-2)
+	for i := 0; i+3 <= len(padded); i++ {
+		grams[string(padded[i:i+3])] = struct{}{}
+	}
+	return grams
+}
@@ -0,0 +1,158 @@
+package search
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+func testProfile(username, first, last string, showName bool, status string) *models.UserProfile {
+	return &models.UserProfile{
+		ID:        primitive.NewObjectID(),
+		Username:  username,
+		FirstName: first,
+		LastName:  last,
+		Status:    status,
+		Privacy:   models.PrivacySettings{ShowName: showName},
+	}
+}
+
+func usernames(hits []Hit) []string {
+	names := []string{}
+	for _, hit := range hits {
+		names = append(names, hit.Username)
+	}
+	return names
+}
+
+func TestIndexSearch(t *testing.T) {
+	ix := NewIndex()
+	for _, p := range []*models.UserProfile{
+		testProfile("kihyun", "Kihyun", "Kim", true, models.StatusActive),
+		testProfile("jane_doe", "Secretname", "Doe", false, models.StatusActive),
+		testProfile("zebracorn", "Zebra", "Corn", true, models.StatusSuspended),
+		testProfile("김기현", "기현", "김", true, models.StatusActive),
+	} {
+		ix.Put(p)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  []string
+	}{
+		{name: "exact username", query: "kihyun", limit: 10, want: []string{"kihyun"}},
+		{name: "typo", query: "kihyn", limit: 10, want: []string{"kihyun"}},
+		{name: "prefix", query: "kih", limit: 10, want: []string{"kihyun"}},
+		{name: "case and accents folded", query: "KÎHYUN", limit: 10, want: []string{"kihyun"}},
+		{name: "public last name", query: "kim", limit: 10, want: []string{"kihyun"}},
+		{name: "username split on underscore", query: "doe", limit: 10, want: []string{"jane_doe"}},
+		{name: "private name is not indexed", query: "secretname", limit: 10, want: []string{}},
+		{name: "inactive profile is not indexed", query: "zebracorn", limit: 10, want: []string{}},
+		{name: "hangul", query: "기현", limit: 10, want: []string{"김기현"}},
+		{name: "no match", query: "zzzz", limit: 10, want: []string{}},
+		{name: "empty query", query: " -_ ", limit: 10, want: []string{}},
+		{name: "limit", query: "kihyun jane", limit: 1, want: []string{"jane_doe"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := usernames(ix.Search(tt.query, tt.limit)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexSearchHighlights(t *testing.T) {
+	ix := NewIndex()
+	ix.Put(testProfile("kihyun", "Kihyun", "Kim", true, models.StatusActive))
+
+	hits := ix.Search("kihyun", 10)
+	if len(hits) != 1 {
+		t.Fatalf("got %d hits, want 1", len(hits))
+	}
+	want := []models.Highlight{
+		{Field: "username", Value: "kihyun", Spans: []models.Span{{Start: 0, End: 6}}},
+		{Field: "first_name", Value: "Kihyun", Spans: []models.Span{{Start: 0, End: 6}}},
+	}
+	if !reflect.DeepEqual(hits[0].Highlights, want) {
+		t.Errorf("highlights = %+v, want %+v", hits[0].Highlights, want)
+	}
+	if hits[0].Score != 1 {
+		t.Errorf("score = %v, want 1 (username match is not weighted down)", hits[0].Score)
+	}
+}
+
+func TestIndexPutRemove(t *testing.T) {
+	ix := NewIndex()
+	profile := testProfile("kihyun", "", "", false, models.StatusActive)
+	ix.Put(profile)
+
+	// username을 바꾸면 이전 username으로는 찾을 수 없어야 함
+	profile.Username = "polaris"
+	ix.Put(profile)
+	if got := usernames(ix.Search("kihyun", 10)); len(got) != 0 {
+		t.Errorf("old username still indexed: %v", got)
+	}
+	if got := usernames(ix.Search("polaris", 10)); !reflect.DeepEqual(got, []string{"polaris"}) {
+		t.Errorf("new username not indexed: %v", got)
+	}
+
+	// active가 아니게 된 프로필은 제거
+	profile.Status = models.StatusBanned
+	ix.Put(profile)
+	if stats := ix.Stats(); stats.Documents != 0 || stats.Trigrams != 0 {
+		t.Errorf("stats after deactivation = %+v, want empty", stats)
+	}
+
+	profile.Status = models.StatusActive
+	ix.Put(profile)
+	ix.Remove(profile.ID)
+	if stats := ix.Stats(); stats.Documents != 0 || stats.Trigrams != 0 {
+		t.Errorf("stats after remove = %+v, want empty", stats)
+	}
+}
+
+func TestIndexRebuild(t *testing.T) {
+	ix := NewIndex()
+	stale := testProfile("stale", "", "", false, models.StatusActive)
+	kept := testProfile("kept", "", "", false, models.StatusActive)
+	removed := testProfile("removed", "", "", false, models.StatusActive)
+	added := testProfile("added", "", "", false, models.StatusActive)
+	ix.Put(stale)
+
+	// 재구성 중에 바뀐 프로필은 스캔 결과보다 현재 상태를 우선
+	stats, err := ix.Rebuild(context.Background(), func(ctx context.Context, fn func(*models.UserProfile) error) error {
+		for _, p := range []*models.UserProfile{kept, removed} {
+			if err := fn(p); err != nil {
+				return err
+			}
+		}
+		ix.Remove(removed.ID)
+		ix.Put(added)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("rebuild: %v", err)
+	}
+	if stats.Documents != 2 || stats.BuiltAt.IsZero() {
+		t.Errorf("stats = %+v, want 2 documents and a build time", stats)
+	}
+
+	for query, want := range map[string][]string{
+		"stale":   {},
+		"kept":    {"kept"},
+		"removed": {},
+		"added":   {"added"},
+	} {
+		if got := usernames(ix.Search(query, 10)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Search(%q) = %v, want %v", query, got, want)
+		}
+	}
+}
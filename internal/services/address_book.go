@@ -0,0 +1,335 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/address"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+const (
+	maxAddresses    = 10 // 주소록에 저장할 수 있는 최대 주소 수
+	maxAddressLabel = 30
+)
+
+// ListAddresses 로그인 사용자의 주소록 조회 (종류별 기본 주소가 먼저)
+func (s *UserService) ListAddresses(ctx context.Context, authID primitive.ObjectID) (*models.AddressBookResponse, error) {
+	profile, err := s.getProfileByAuthID(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*models.AddressEntry, 0, len(profile.Addresses))
+	for _, typ := range models.AddressTypes {
+		for _, entry := range profile.Addresses {
+			if entry.Type == typ && entry.Default {
+				items = append(items, entry)
+			}
+		}
+		for _, entry := range profile.Addresses {
+			if entry.Type == typ && !entry.Default {
+				items = append(items, entry)
+			}
+		}
+	}
+	return &models.AddressBookResponse{Items: items}, nil
+}
+
+// GetAddress 주소록 항목 조회
+func (s *UserService) GetAddress(ctx context.Context, authID, addressID primitive.ObjectID) (*models.AddressEntry, error) {
+	profile, err := s.getProfileByAuthID(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+	entry := findAddress(profile.Addresses, addressID)
+	if entry == nil {
+		return nil, ErrAddressNotFound
+	}
+	return entry, nil
+}
+
+// FormatAddress 주소록 항목을 받는 국가의 주소 서식으로 변환
+// origin(보내는 국가)이 받는 국가와 다르거나 비어 있으면 국가 이름 줄을 추가
+func (s *UserService) FormatAddress(ctx context.Context, authID, addressID primitive.ObjectID, origin string) (*models.FormattedAddress, error) {
+	if origin = strings.TrimSpace(origin); origin != "" {
+		code, ok := address.ResolveCountry(origin)
+		if !ok {
+			var violations fieldErrors
+			violations.add("origin", utils.ViolationInvalidValue, map[string]string{"value": origin},
+				"unknown country %q: use an ISO 3166-1 alpha-2 code", origin)
+			return nil, violations.err()
+		}
+		origin = code
+	}
+
+	entry, err := s.GetAddress(ctx, authID, addressID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := address.Fields{
+		Street:     entry.Address.Street,
+		City:       entry.Address.City,
+		State:      entry.Address.State,
+		PostalCode: entry.Address.PostalCode,
+		Country:    entry.Address.Country,
+	}
+	country, ok := address.ResolveCountry(fields.Country)
+	if !ok {
+		country = fields.Country
+	}
+	lines := address.Format(fields, origin)
+	return &models.FormattedAddress{
+		Country: country,
+		Origin:  origin,
+		Lines:   lines,
+		Text:    strings.Join(lines, "\n"),
+	}, nil
+}
+
+// CreateAddress 주소록에 주소 추가 (같은 종류의 첫 주소는 기본 주소가 됨)
+func (s *UserService) CreateAddress(ctx context.Context, authID primitive.ObjectID, req *models.AddressRequest) (*models.AddressEntry, error) {
+	profile, err := s.editableProfileByAuthID(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateAddressRequest(req); err != nil {
+		return nil, err
+	}
+	if len(profile.Addresses) >= maxAddresses {
+		return nil, fmt.Errorf("%w: at most %d addresses can be saved", ErrAddressLimit, maxAddresses)
+	}
+	s.locate(ctx, &req.Address)
+
+	now := time.Now()
+	entry := &models.AddressEntry{
+		ID:        primitive.NewObjectID(),
+		Type:      req.Type,
+		Label:     req.Label,
+		Default:   req.Default || defaultAddress(profile.Addresses, req.Type) == nil,
+		Address:   req.Address,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	addresses := append(copyAddresses(profile.Addresses), entry)
+	if entry.Default {
+		setDefault(addresses, entry)
+	}
+
+	if err := s.saveAddresses(ctx, profile, addresses); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// UpdateAddress 주소록 항목 전체 교체
+// 종류를 바꾸면 새 종류의 기본 주소가 없을 때 기본 주소가 되고, 원래 종류의 기본 주소는 다른 항목으로 넘어감
+func (s *UserService) UpdateAddress(ctx context.Context, authID, addressID primitive.ObjectID, req *models.AddressRequest) (*models.AddressEntry, error) {
+	profile, err := s.editableProfileByAuthID(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+	current := findAddress(profile.Addresses, addressID)
+	if current == nil {
+		return nil, ErrAddressNotFound
+	}
+	if err := s.validateAddressRequest(req); err != nil {
+		return nil, err
+	}
+	s.relocateAddress(ctx, &req.Address, current.Address)
+
+	addresses := copyAddresses(profile.Addresses)
+	entry := findAddress(addresses, addressID)
+	oldType, wasDefault := entry.Type, entry.Default
+
+	entry.Type = req.Type
+	entry.Label = req.Label
+	entry.Address = req.Address
+	entry.UpdatedAt = time.Now()
+	switch {
+	case req.Default:
+		entry.Default = true
+	case oldType != req.Type:
+		entry.Default = false
+	}
+	// 기본 주소 해제는 다른 항목을 기본으로 지정해야 가능 (종류별 기본 주소 유지)
+
+	if oldType != entry.Type && wasDefault {
+		promoteDefault(addresses, oldType, entry.ID)
+	}
+	if entry.Default || defaultAddress(addresses, entry.Type) == nil {
+		setDefault(addresses, entry)
+	}
+
+	if err := s.saveAddresses(ctx, profile, addresses); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// DeleteAddress 주소록 항목 삭제 (기본 주소였으면 같은 종류의 가장 오래된 주소가 기본이 됨)
+func (s *UserService) DeleteAddress(ctx context.Context, authID, addressID primitive.ObjectID) error {
+	profile, err := s.editableProfileByAuthID(ctx, authID)
+	if err != nil {
+		return err
+	}
+	removed := findAddress(profile.Addresses, addressID)
+	if removed == nil {
+		return ErrAddressNotFound
+	}
+
+	addresses := make([]*models.AddressEntry, 0, len(profile.Addresses))
+	for _, entry := range copyAddresses(profile.Addresses) {
+		if entry.ID != addressID {
+			addresses = append(addresses, entry)
+		}
+	}
+	if removed.Default {
+		promoteDefault(addresses, removed.Type, addressID)
+	}
+
+	return s.saveAddresses(ctx, profile, addresses)
+}
+
+// editableProfileByAuthID 주소록을 수정할 수 있는 상태의 프로필 조회
+func (s *UserService) editableProfileByAuthID(ctx context.Context, authID primitive.ObjectID) (*models.UserProfile, error) {
+	profile, err := s.getProfileByAuthID(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+	if !isEditable(profile.Status) {
+		return nil, fmt.Errorf("%w while %s", ErrProfileNotEditable, profile.Status)
+	}
+	return profile, nil
+}
+
+// saveAddresses 주소록 저장 (기존 address 필드는 기본 배송지와 같게 유지하고, 기본 배송지가 없어지면 제거)
+func (s *UserService) saveAddresses(ctx context.Context, profile *models.UserProfile, addresses []*models.AddressEntry) error {
+	update := repository.ProfileUpdate{Set: bson.M{"addresses": addresses}}
+	if shipping := defaultAddress(addresses, models.AddressShipping); shipping != nil {
+		update.Set["address"] = shipping.Address
+	} else if defaultAddress(profile.Addresses, models.AddressShipping) != nil {
+		update.Unset = append(update.Unset, "address")
+	}
+	return s.applyUpdate(ctx, profile, update, models.Precondition{})
+}
+
+// routeLegacyAddress 주소록을 쓰는 프로필에서 address 필드를 바꾸면 기본 배송지에도 반영 (주소록이 비어 있으면 그대로)
+// 기본 배송지가 없으면 새 기본 배송지로 추가하며, addr가 nil(address 삭제)이면 기본 배송지는 주소록에서 지우도록 거부
+func routeLegacyAddress(profile *models.UserProfile, update *repository.ProfileUpdate, addr *models.Address) error {
+	if len(profile.Addresses) == 0 {
+		return nil
+	}
+	addresses := copyAddresses(profile.Addresses)
+	shipping := defaultAddress(addresses, models.AddressShipping)
+
+	now := time.Now()
+	switch {
+	case addr == nil && shipping == nil:
+		return nil
+	case addr == nil:
+		var violations fieldErrors
+		violations.add("address", utils.ViolationNotRemovable, nil, "address is the default shipping address: delete it from the address book instead")
+		return violations.err()
+	case shipping == nil:
+		if len(addresses) >= maxAddresses {
+			return fmt.Errorf("%w: at most %d addresses can be saved", ErrAddressLimit, maxAddresses)
+		}
+		addresses = append(addresses, &models.AddressEntry{
+			ID:        primitive.NewObjectID(),
+			Type:      models.AddressShipping,
+			Default:   true,
+			Address:   *addr,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	default:
+		shipping.Address = *addr
+		shipping.UpdatedAt = now
+	}
+	update.Set["addresses"] = addresses
+	return nil
+}
+
+func (s *UserService) validateAddressRequest(req *models.AddressRequest) error {
+	var violations fieldErrors
+	req.Label = strings.TrimSpace(req.Label)
+
+	if req.Type == "" {
+		violations.add("type", utils.ViolationRequired, nil, "type is required")
+	} else if !isAddressType(req.Type) {
+		violations.add("type", utils.ViolationInvalidValue, map[string]string{"value": req.Type}, "invalid address type: %s", req.Type)
+	}
+	if utf8.RuneCountInString(req.Label) > maxAddressLabel {
+		violations.add("label", utils.ViolationTooLong, map[string]string{"max": strconv.Itoa(maxAddressLabel)},
+			"label must be at most %d characters", maxAddressLabel)
+	}
+	validateAddress(&violations, &req.Address)
+	return violations.err()
+}
+
+func isAddressType(typ string) bool {
+	for _, t := range models.AddressTypes {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func findAddress(addresses []*models.AddressEntry, id primitive.ObjectID) *models.AddressEntry {
+	for _, entry := range addresses {
+		if entry.ID == id {
+			return entry
+		}
+	}
+	return nil
+}
+
+func defaultAddress(addresses []*models.AddressEntry, typ string) *models.AddressEntry {
+	for _, entry := range addresses {
+		if entry.Type == typ && entry.Default {
+			return entry
+		}
+	}
+	return nil
+}
+
+// setDefault entry를 같은 종류의 유일한 기본 주소로 지정
+func setDefault(addresses []*models.AddressEntry, entry *models.AddressEntry) {
+	for _, other := range addresses {
+		if other.Type == entry.Type {
+			other.Default = other.ID == entry.ID
+		}
+	}
+}
+
+// promoteDefault typ 종류에서 except를 제외한 가장 먼저 추가된 주소를 기본으로 지정
+func promoteDefault(addresses []*models.AddressEntry, typ string, except primitive.ObjectID) {
+	for _, entry := range addresses {
+		if entry.Type == typ && entry.ID != except {
+			setDefault(addresses, entry)
+			return
+		}
+	}
+}
+
+// copyAddresses 저장 전까지 조회한 프로필이 바뀌지 않도록 항목 복사
+func copyAddresses(addresses []*models.AddressEntry) []*models.AddressEntry {
+	copied := make([]*models.AddressEntry, 0, len(addresses))
+	for _, entry := range addresses {
+		c := *entry
+		copied = append(copied, &c)
+	}
+	return copied
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	maxReasonLength = 500
+)
+
+// 목록 정렬에 허용되는 필드
+var sortableFields = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+	"username":   true,
+	"email":      true,
+}
+
+// ListProfiles 관리자용 프로필 목록 조회 (비공개 필드 포함)
+func (s *UserService) ListProfiles(ctx context.Context, filter models.ProfileFilter, opts models.ListOptions) (*models.ProfileListResponse, error) {
+	var violations fieldErrors
+	if _, ok := statusTransitions[filter.Status]; filter.Status != "" && !ok {
+		violations.add("status", utils.ViolationInvalidValue, map[string]string{"value": filter.Status}, "invalid status filter: %s", filter.Status)
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		violations.add("created_from", utils.ViolationInvalidValue, nil, "created_from must be before created_to")
+	}
+
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+	if opts.PageSize < 1 {
+		opts.PageSize = defaultPageSize
+	}
+	if opts.PageSize > maxPageSize {
+		violations.add("page_size", utils.ViolationTooLarge, map[string]string{"max": strconv.Itoa(maxPageSize)}, "page_size must not exceed %d", maxPageSize)
+	}
+	if opts.SortBy == "" {
+		opts.SortBy = "created_at"
+		opts.SortDesc = true
+	}
+	if !sortableFields[opts.SortBy] {
+		violations.add("sort", utils.ViolationInvalidValue, map[string]string{"value": opts.SortBy}, "cannot sort by %s", opts.SortBy)
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	profiles, total, err := s.repo.ListProfiles(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*models.ProfileResponse, len(profiles))
+	for i, profile := range profiles {
+		items[i] = models.NewProfileResponse(profile, models.ViewAdmin)
+	}
+
+	return &models.ProfileListResponse{
+		Items:    items,
+		Page:     opts.Page,
+		PageSize: opts.PageSize,
+		Total:    total,
+	}, nil
+}
+
+// AdminGetProfile 관리자용 프로필 조회 (비공개 필드와 상태 변경 정보 포함)
+func (s *UserService) AdminGetProfile(ctx context.Context, userID primitive.ObjectID) (*models.ProfileResponse, error) {
+	profile, err := s.getProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewProfileResponse(profile, models.ViewAdmin), nil
+}
+
+// ChangeStatus 관리자의 프로필 상태 변경 (사유 필수)
+func (s *UserService) ChangeStatus(ctx context.Context, userID primitive.ObjectID, actor models.Viewer, req *models.UpdateStatusRequest) error {
+	var violations fieldErrors
+	if _, ok := statusTransitions[req.Status]; !ok {
+		violations.add("status", utils.ViolationInvalidValue, map[string]string{"value": req.Status}, "invalid status: %s", req.Status)
+	} else if req.Status == models.StatusErased {
+		violations.add("status", utils.ViolationNotAllowed, map[string]string{"value": req.Status}, "erasure must go through the erasure flow")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		violations.add("reason", utils.ViolationRequired, nil, "reason is required")
+	} else if len(reason) > maxReasonLength {
+		violations.add("reason", utils.ViolationTooLong, map[string]string{"max": strconv.Itoa(maxReasonLength)}, "reason must not exceed %d characters", maxReasonLength)
+	}
+	if req.Until != nil {
+		if req.Status != models.StatusSuspended {
+			violations.add("until", utils.ViolationNotAllowed, nil, "until is only allowed when suspending a profile")
+		} else if !req.Until.After(time.Now()) {
+			violations.add("until", utils.ViolationInvalidValue, nil, "until must be in the future")
+		}
+	}
+	if err := violations.err(); err != nil {
+		return err
+	}
+
+	profile, err := s.getProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if profile.Status == req.Status {
+		return fmt.Errorf("%w: profile is already %s", ErrInvalidTransition, req.Status)
+	}
+
+	return s.transition(ctx, profile, req.Status, adminActor(actor), reason, req.Until)
+}
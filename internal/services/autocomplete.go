@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	defaultAutocompleteLimit   = 8
+	maxAutocompleteLimit       = 20
+	maxAutocompleteQueryLength = 100
+)
+
+// AutocompleteProfiles username과 공개된 이름의 접두어로 active 프로필 검색
+// 대소문자와 발음 구별 기호를 무시하며 공개 가능한 최소 필드만 반환
+func (s *UserService) AutocompleteProfiles(ctx context.Context, query string, limit int64) (*models.AutocompleteResponse, error) {
+	prefix := utils.FoldSearchKey(query)
+
+	var violations fieldErrors
+	switch {
+	case prefix == "":
+		violations.add("q", utils.ViolationRequired, nil, "search query is required")
+	case utf8.RuneCountInString(prefix) > maxAutocompleteQueryLength:
+		violations.add("q", utils.ViolationTooLong, map[string]string{"max": strconv.Itoa(maxAutocompleteQueryLength)},
+			"search query must be at most %d characters", maxAutocompleteQueryLength)
+	}
+	if limit == 0 {
+		limit = defaultAutocompleteLimit
+	}
+	if limit > maxAutocompleteLimit {
+		violations.add("limit", utils.ViolationTooLarge, map[string]string{"max": strconv.Itoa(maxAutocompleteLimit)},
+			"limit must not exceed %d", maxAutocompleteLimit)
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	profiles, err := s.repo.AutocompleteProfiles(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.AutocompleteResponse{Items: make([]models.AutocompleteItem, 0, len(profiles))}
+	for _, profile := range profiles {
+		response.Items = append(response.Items, models.NewAutocompleteItem(profile))
+	}
+	return response, nil
+}
+
+// searchKeysAfter 업데이트가 검색 키에 쓰이는 필드를 바꾸면 변경 후 기준으로 다시 만든 검색 키 반환
+func searchKeysAfter(profile *models.UserProfile, set bson.M) ([]string, bool) {
+	updated := *profile
+	changed := false
+	for path, value := range set {
+		switch path {
+		case "username":
+			updated.Username, _ = value.(string)
+		case "first_name":
+			updated.FirstName, _ = value.(string)
+		case "last_name":
+			updated.LastName, _ = value.(string)
+		case "privacy":
+			if privacy, ok := value.(*models.PrivacySettings); ok {
+				updated.Privacy = *privacy
+			}
+		case "privacy.show_name":
+			updated.Privacy.ShowName, _ = value.(bool)
+		default:
+			continue
+		}
+		changed = true
+	}
+	if !changed {
+		return nil, false
+	}
+	return models.BuildSearchKeys(&updated), true
+}
+
+// withSearchKeys 필요하면 검색 키 갱신을 업데이트에 추가
+func withSearchKeys(profile *models.UserProfile, update repository.ProfileUpdate) repository.ProfileUpdate {
+	if keys, ok := searchKeysAfter(profile, update.Set); ok {
+		update.Set["search_keys"] = keys
+	}
+	return update
+}
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/imaging"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// avatarMaxUploadPixels 디코딩을 시작하기 전에 거부하는 업로드 이미지의 픽셀 수 한도 (25MP, RGBA로 약 100MB)
+const avatarMaxUploadPixels = 25_000_000
+
+// avatarContentTypes 업로드할 수 있는 이미지 형식 (내용으로 판별한 Content-Type 기준)
+var avatarContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// BlobStore 업로드 파일 저장소 (로컬 디렉터리, S3 호환 저장소 등)
+type BlobStore interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string // 클라이언트가 파일을 받을 주소
+}
+
+// AvatarPolicy 프로필 이미지 업로드 제한과 생성할 이미지 크기
+type AvatarPolicy struct {
+	MaxBytes       int64 // 업로드 파일 크기 한도
+	MaxSide        int   // original 이미지의 가로, 세로 최대값 (더 크면 비율을 유지해 축소)
+	MinSide        int   // 업로드 이미지의 가로, 세로 최소값
+	ThumbnailSizes []int // 정사각형 썸네일 한 변의 길이
+}
+
+// DefaultAvatarPolicy 기본 정책 (5MB, 1024px, 최소 64px, 썸네일 256/128/64px)
+func DefaultAvatarPolicy() AvatarPolicy {
+	return AvatarPolicy{
+		MaxBytes:       5 << 20,
+		MaxSide:        1024,
+		MinSide:        64,
+		ThumbnailSizes: []int{256, 128, 64},
+	}
+}
+
+// WithAvatars 프로필 이미지 저장소와 업로드 정책 설정 (0인 값은 기본값 사용)
+func (s *UserService) WithAvatars(store BlobStore, policy AvatarPolicy) *UserService {
+	defaults := DefaultAvatarPolicy()
+	if policy.MaxBytes <= 0 {
+		policy.MaxBytes = defaults.MaxBytes
+	}
+	if policy.MaxSide <= 0 {
+		policy.MaxSide = defaults.MaxSide
+	}
+	if policy.MinSide <= 0 {
+		policy.MinSide = defaults.MinSide
+	}
+	if len(policy.ThumbnailSizes) == 0 {
+		policy.ThumbnailSizes = defaults.ThumbnailSizes
+	}
+	s.avatars = store
+	s.avatarPolicy = policy
+	return s
+}
+
+// UploadAvatar 로그인 사용자의 프로필 이미지 업로드
+// 이미지를 다시 인코딩해 EXIF 등 메타데이터를 제거하고, 크기별 썸네일과 함께 저장한 뒤 프로필에 반영
+// 이전에 업로드한 이미지 파일은 프로필이 바뀐 뒤 삭제
+func (s *UserService) UploadAvatar(ctx context.Context, authID primitive.ObjectID, upload *models.AvatarUpload) (*models.AvatarResponse, error) {
+	if s.avatars == nil {
+		return nil, ErrAvatarDisabled
+	}
+	profile, err := s.editableProfileByAuthID(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := s.decodeAvatar(upload)
+	if err != nil {
+		return nil, err
+	}
+	images, err := s.storeAvatar(ctx, authID, img)
+	if err != nil {
+		return nil, err
+	}
+
+	update := repository.ProfileUpdate{Set: bson.M{
+		"avatar":        images[0].URL,
+		"avatar_images": images,
+	}}
+	if err := s.applyUpdate(ctx, profile, update, models.Precondition{}); err != nil {
+		s.deleteAvatarImages(ctx, images)
+		return nil, err
+	}
+	s.deleteAvatarImages(ctx, profile.AvatarImages)
+
+	return &models.AvatarResponse{Avatar: images[0].URL, Images: images}, nil
+}
+
+// AvatarMaxBytes 업로드 파일 크기 한도 (핸들러의 요청 본문 크기 제한용)
+func (s *UserService) AvatarMaxBytes() int64 {
+	return s.avatarPolicy.MaxBytes
+}
+
+// DeleteAvatar 로그인 사용자의 프로필 이미지 제거 (없으면 아무것도 하지 않음)
+func (s *UserService) DeleteAvatar(ctx context.Context, authID primitive.ObjectID) error {
+	profile, err := s.editableProfileByAuthID(ctx, authID)
+	if err != nil {
+		return err
+	}
+	if profile.Avatar == "" && len(profile.AvatarImages) == 0 {
+		return nil
+	}
+
+	update := repository.ProfileUpdate{
+		Set:   bson.M{"avatar": ""},
+		Unset: []string{"avatar_images"},
+	}
+	if err := s.applyUpdate(ctx, profile, update, models.Precondition{}); err != nil {
+		return err
+	}
+	s.deleteAvatarImages(ctx, profile.AvatarImages)
+	return nil
+}
+
+// decodeAvatar 업로드 파일의 크기, 형식을 검증하고 original 크기로 축소해 디코딩
+func (s *UserService) decodeAvatar(upload *models.AvatarUpload) (*image.RGBA, error) {
+	var violations fieldErrors
+	policy := s.avatarPolicy
+
+	data, err := io.ReadAll(io.LimitReader(upload.File, policy.MaxBytes+1))
+	var bodyTooLarge *http.MaxBytesError // 핸들러의 요청 본문 제한에 먼저 걸린 경우
+	if err != nil && !errors.As(err, &bodyTooLarge) {
+		return nil, err
+	}
+	if len(data) == 0 && bodyTooLarge == nil {
+		violations.add("avatar", utils.ViolationRequired, nil, "avatar image is required")
+		return nil, violations.err()
+	}
+	if int64(len(data)) > policy.MaxBytes || bodyTooLarge != nil {
+		size := formatBytes(policy.MaxBytes)
+		violations.add("avatar", utils.ViolationTooLarge, map[string]string{"max": size}, "avatar image must not be larger than %s", size)
+		return nil, violations.err()
+	}
+
+	// 선언한 형식과 실제 내용이 다르면 거부 (application/octet-stream은 내용으로만 판별)
+	detected, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	declared, _, _ := mime.ParseMediaType(upload.ContentType)
+	switch {
+	case !avatarContentTypes[detected]:
+		violations.add("avatar", utils.ViolationInvalidValue, map[string]string{"value": detected}, "unsupported image type: %s", detected)
+	case declared != "" && declared != "application/octet-stream" && declared != detected:
+		violations.add("avatar", utils.ViolationInvalidType, map[string]string{"value": declared, "detected": detected},
+			"content type %s does not match image data (%s)", declared, detected)
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	img, config, err := imaging.Decode(data, avatarMaxUploadPixels, policy.MaxSide)
+	switch {
+	case errors.Is(err, imaging.ErrTooLarge):
+		size := fmt.Sprintf("%d MP", avatarMaxUploadPixels/1_000_000)
+		violations.add("avatar", utils.ViolationTooLarge, map[string]string{"max": size}, "avatar image must not be larger than %s", size)
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		violations.add("avatar", utils.ViolationInvalidValue, map[string]string{"value": detected}, "unsupported image type: %s", detected)
+	case err != nil:
+		violations.add("avatar", utils.ViolationInvalidFormat, nil, "avatar image data is corrupted")
+	case config.Width < policy.MinSide || config.Height < policy.MinSide:
+		size := fmt.Sprintf("%dx%d px", policy.MinSide, policy.MinSide)
+		violations.add("avatar", utils.ViolationTooShort, map[string]string{"min": size}, "avatar image must be at least %s", size)
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// storeAvatar original 크기로 축소한 이미지와 썸네일을 인코딩해 저장 (하나라도 실패하면 저장한 파일을 지우고 에러)
+// 키에 업로드마다 새 ID를 넣어 같은 URL의 내용이 바뀌지 않게 함 (CDN, 브라우저 캐시 무효화 불필요)
+func (s *UserService) storeAvatar(ctx context.Context, authID primitive.ObjectID, img *image.RGBA) ([]models.AvatarImage, error) {
+	prefix := "avatars/" + authID.Hex() + "/" + primitive.NewObjectID().Hex() + "/"
+
+	type variant struct {
+		size  string
+		image *image.RGBA
+	}
+	variants := []variant{{models.AvatarOriginal, img}}
+	for _, side := range s.avatarPolicy.ThumbnailSizes {
+		variants = append(variants, variant{fmt.Sprintf("%dx%d", side, side), imaging.Thumbnail(img, side)})
+	}
+
+	images := make([]models.AvatarImage, 0, len(variants))
+	for _, v := range variants {
+		data, contentType, err := imaging.Encode(v.image)
+		if err != nil {
+			s.deleteAvatarImages(ctx, images)
+			return nil, err
+		}
+		key := prefix + v.size + imaging.Extension(contentType)
+		if err := s.avatars.Put(ctx, key, contentType, data); err != nil {
+			s.deleteAvatarImages(ctx, images)
+			return nil, fmt.Errorf("%w: %v", ErrAvatarStorageFailed, err)
+		}
+		bounds := v.image.Bounds()
+		images = append(images, models.AvatarImage{
+			Size:        v.size,
+			Key:         key,
+			URL:         s.avatars.URL(key),
+			Width:       bounds.Dx(),
+			Height:      bounds.Dy(),
+			ContentType: contentType,
+		})
+	}
+	return images, nil
+}
+
+// deleteAvatarImages 저장한 이미지 파일 삭제 (실패는 로그만 남김)
+func (s *UserService) deleteAvatarImages(ctx context.Context, images []models.AvatarImage) {
+	if s.avatars == nil {
+		return
+	}
+	for _, file := range images {
+		if err := s.avatars.Delete(ctx, file.Key); err != nil {
+			log.Printf("Failed to delete avatar file %s: %v", file.Key, err)
+		}
+	}
+}
+
+// formatBytes 크기 한도를 사람이 읽기 쉬운 단위로 표시 (5242880 -> 5 MB)
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20 && n%(1<<20) == 0:
+		return strconv.FormatInt(n>>20, 10) + " MB"
+	case n >= 1<<10 && n%(1<<10) == 0:
+		return strconv.FormatInt(n>>10, 10) + " KB"
+	default:
+		return strconv.FormatInt(n, 10) + " bytes"
+	}
+}
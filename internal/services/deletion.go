@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// 파기 실행 계기
+const (
+	erasureScheduled = "scheduled"
+	erasureAdmin     = "admin"
+)
+
+// RestoreProfile 탈퇴한 프로필 복구 (소유자 또는 관리자, 유예 기간 내에만 가능)
+func (s *UserService) RestoreProfile(ctx context.Context, userID primitive.ObjectID, viewer models.Viewer) error {
+	profile, err := s.getProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	switch viewer.ViewOf(profile) {
+	case models.ViewOwner:
+		return s.restore(ctx, profile, ownerActor(viewer))
+	case models.ViewAdmin:
+		return s.restore(ctx, profile, adminActor(viewer))
+	default:
+		return ErrNotProfileOwner
+	}
+}
+
+// RestoreProfileByAuthID 로그인 사용자의 탈퇴 프로필 복구 (/users/me)
+func (s *UserService) RestoreProfileByAuthID(ctx context.Context, authID primitive.ObjectID) error {
+	profile, err := s.getProfileByAuthID(ctx, authID)
+	if err != nil {
+		return err
+	}
+
+	return s.restore(ctx, profile, models.Actor{Type: models.ActorOwner, ID: authID.Hex()})
+}
+
+func (s *UserService) restore(ctx context.Context, profile *models.UserProfile, actor models.Actor) error {
+	if profile.Status == models.StatusErased || s.deletionExpired(profile, time.Now()) {
+		return ErrRestoreExpired
+	}
+	if profile.Status != models.StatusInactive {
+		return fmt.Errorf("%w: only deleted profiles can be restored", ErrInvalidTransition)
+	}
+
+	return s.transition(ctx, profile, models.StatusActive, actor, "restored by "+actor.Type, nil)
+}
+
+// deletionExpired 탈퇴 후 유예 기간이 지났는지 확인
+func (s *UserService) deletionExpired(profile *models.UserProfile, now time.Time) bool {
+	return profile.Status == models.StatusInactive &&
+		profile.StatusChangedAt != nil &&
+		now.Sub(*profile.StatusChangedAt) >= s.deletionGracePeriod
+}
+
+// checkUsernameAvailable username 사용 가능 여부 확인
+// 대소문자나 모양이 비슷한 글자만 다른 username(utils.UsernameKey가 같은 값)도 사용 중으로 판단
+// 유예 기간이 지난 탈퇴 프로필이 점유 중이면 파기 작업을 앞당겨 실행한 뒤 사용 가능으로 판단
+func (s *UserService) checkUsernameAvailable(ctx context.Context, username string, selfID primitive.ObjectID) error {
+	existing, err := s.repo.GetProfileByUsernameKey(ctx, utils.UsernameKey(username))
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.ID == selfID {
+		return nil
+	}
+	if !s.deletionExpired(existing, time.Now()) {
+		return ErrUsernameTaken
+	}
+
+	_, err = s.erase(ctx, existing, models.SystemActor, erasureScheduled)
+	return err
+}
+
+// EraseProfile 관리자의 즉시 개인정보 파기 (상태와 관계없이 실행)
+func (s *UserService) EraseProfile(ctx context.Context, userID primitive.ObjectID, actor models.Viewer) (*models.ErasureReport, error) {
+	profile, err := s.getProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.erase(ctx, profile, adminActor(actor), erasureAdmin)
+}
+
+// PurgeExpiredDeletions 유예 기간이 지난 탈퇴 프로필 일괄 파기
+func (s *UserService) PurgeExpiredDeletions(ctx context.Context) (*models.PurgeReport, error) {
+	report := &models.PurgeReport{
+		StartedAt: time.Now(),
+		Erased:    []*models.ErasureReport{},
+		Failed:    []string{},
+	}
+
+	cutoff := report.StartedAt.Add(-s.deletionGracePeriod)
+	profiles, err := s.repo.ListExpiredDeletions(ctx, cutoff, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, profile := range profiles {
+		erased, err := s.erase(ctx, profile, models.SystemActor, erasureScheduled)
+		if err != nil {
+			log.Printf("Failed to erase profile %s: %v", profile.ID.Hex(), err)
+			report.Failed = append(report.Failed, profile.ID.Hex())
+			continue
+		}
+		report.Erased = append(report.Erased, erased)
+	}
+
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// RunPurge 백그라운드 파기 작업 (결과는 로그로 남김)
+func (s *UserService) RunPurge(ctx context.Context) error {
+	report, err := s.PurgeExpiredDeletions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, erased := range report.Erased {
+		log.Printf("Erased profile %s (auth %s): %v", erased.ProfileID, erased.AuthID, erased.ErasedFields)
+	}
+	if len(report.Erased) > 0 || len(report.Failed) > 0 {
+		log.Printf("Purge finished: %d erased, %d failed", len(report.Erased), len(report.Failed))
+	}
+	return nil
+}
+
+// erase 프로필 문서를 auth_id와 시각 정보만 남긴 tombstone으로 교체
+func (s *UserService) erase(ctx context.Context, profile *models.UserProfile, actor models.Actor, trigger string) (*models.ErasureReport, error) {
+	if !canTransition(actor, profile.Status, models.StatusErased) {
+		return nil, fmt.Errorf("%w: %s cannot erase a profile that is %s", ErrInvalidTransition, actor.Type, profile.Status)
+	}
+
+	now := time.Now()
+	tombstone := &models.UserProfile{
+		ID:              profile.ID,
+		AuthID:          profile.AuthID,
+		Username:        erasedUsername(profile.ID), // username 유니크 인덱스 유지용 자리표시자
+		Status:          models.StatusErased,
+		CreatedAt:       profile.CreatedAt,
+		UpdatedAt:       now,
+		Version:         profile.Version + 1,
+		StatusChangedAt: &now,
+		StatusChangedBy: actor.ID,
+		ErasedAt:        &now,
+	}
+
+	if err := s.repo.EraseProfile(ctx, profile.ID, profile.Status, tombstone); err != nil {
+		return nil, err
+	}
+	s.unindexProfile(profile.ID)
+
+	// 내보내기 결과 파일에도 개인정보가 남아 있으므로 함께 삭제
+	if s.exports != nil {
+		if _, err := s.exports.DeleteExportsByAuthID(ctx, profile.AuthID); err != nil {
+			log.Printf("Failed to delete data exports of %s: %v", profile.AuthID.Hex(), err)
+		}
+	}
+	if s.verifications != nil {
+		if _, err := s.verifications.DeleteVerificationsByAuthID(ctx, profile.AuthID); err != nil {
+			log.Printf("Failed to delete phone verifications of %s: %v", profile.AuthID.Hex(), err)
+		}
+	}
+	s.deleteAvatarImages(ctx, profile.AvatarImages)
+
+	return &models.ErasureReport{
+		ProfileID:    profile.ID.Hex(),
+		AuthID:       profile.AuthID.Hex(),
+		Trigger:      trigger,
+		ErasedFields: erasedFields(profile),
+		ErasedAt:     now,
+	}, nil
+}
+
+// erasedFields 파기 전 값이 있던 개인정보 필드 목록
+func erasedFields(profile *models.UserProfile) []string {
+	fields := []string{}
+	add := func(name string, present bool) {
+		if present {
+			fields = append(fields, name)
+		}
+	}
+
+	add("email", profile.Email != "")
+	add("username", profile.Username != "")
+	add("first_name", profile.FirstName != "")
+	add("last_name", profile.LastName != "")
+	add("phone_number", profile.PhoneNumber != "")
+	add("address", profile.Address != models.Address{})
+	add("addresses", len(profile.Addresses) > 0)
+	add("avatar", profile.Avatar != "")
+	add("privacy", profile.Privacy != models.PrivacySettings{})
+	add("status_reason", profile.StatusReason != "")
+	add("status_history", len(profile.StatusHistory) > 0)
+	return fields
+}
+
+func erasedUsername(id primitive.ObjectID) string {
+	return "erased-" + id.Hex()
+}
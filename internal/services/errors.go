@@ -0,0 +1,59 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/apperror"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+)
+
+var (
+	ErrProfileNotFound     = repository.ErrProfileNotFound
+	ErrProfileExists       = repository.ErrProfileExists
+	ErrUsernameTaken       = repository.ErrUsernameTaken
+	ErrPhoneTaken          = repository.ErrPhoneTaken
+	ErrProfileNotCreated   = apperror.New(apperror.NotFound, "profile_not_created", "profile not created") // 로그인 사용자가 아직 프로필을 만들지 않음
+	ErrNotProfileOwner     = apperror.New(apperror.Forbidden, "not_profile_owner", "unauthorized to modify this profile")
+	ErrProfileNotEditable  = apperror.New(apperror.Conflict, "profile_not_editable", "profile cannot be modified")
+	ErrInvalidTransition   = apperror.New(apperror.Conflict, "invalid_transition", "invalid status transition")
+	ErrRestoreExpired      = apperror.New(apperror.Gone, "restore_expired", "restore period has expired")
+	ErrVersionConflict     = repository.ErrVersionConflict
+	ErrPreconditionFailed  = apperror.New(apperror.PreconditionFailed, "precondition_failed", "profile has been modified since it was retrieved") // If-Match 불일치
+	ErrUnsupportedPatch    = apperror.New(apperror.Unsupported, "unsupported_patch", "unsupported patch content type")
+	ErrInvalidPatch        = apperror.New(apperror.Validation, "invalid_patch", "invalid patch document")
+	ErrExportNotFound      = repository.ErrExportNotFound
+	ErrExportInProgress    = repository.ErrExportInProgress
+	ErrExportNotReady      = apperror.New(apperror.Conflict, "export_not_ready", "export is not ready for download")
+	ErrExportDisabled      = apperror.New(apperror.Unavailable, "export_disabled", "data export is not configured")
+	ErrAddressNotFound     = apperror.New(apperror.NotFound, "address_not_found", "address not found")
+	ErrAddressLimit        = apperror.New(apperror.Conflict, "address_limit_reached", "address book is full")
+	ErrSearchIndexDisabled = apperror.New(apperror.Unavailable, "search_index_disabled", "fuzzy search index is not configured")
+
+	ErrVerificationDisabled     = apperror.New(apperror.Unavailable, "phone_verification_disabled", "phone verification is not configured")
+	ErrPhoneNumberMissing       = apperror.New(apperror.Conflict, "phone_number_missing", "profile has no phone number to verify")
+	ErrPhoneAlreadyVerified     = apperror.New(apperror.Conflict, "phone_already_verified", "phone number is already verified")
+	ErrVerificationRateLimited  = apperror.New(apperror.TooManyRequests, "verification_rate_limited", "too many verification codes requested")
+	ErrVerificationNotFound     = repository.ErrVerificationNotFound
+	ErrVerificationExpired      = apperror.New(apperror.Gone, "verification_expired", "verification code has expired")
+	ErrVerificationCodeMismatch = apperror.New(apperror.Validation, "verification_code_mismatch", "verification code does not match")
+	ErrVerificationAttempts     = apperror.New(apperror.TooManyRequests, "verification_attempts_exceeded", "too many wrong verification codes")
+	ErrSMSDeliveryFailed        = apperror.New(apperror.Unavailable, "sms_delivery_failed", "failed to send verification code")
+
+	ErrAvatarDisabled      = apperror.New(apperror.Unavailable, "avatar_upload_disabled", "avatar upload is not configured")
+	ErrAvatarStorageFailed = apperror.New(apperror.Unavailable, "avatar_storage_failed", "failed to store avatar image")
+)
+
+// RetryError 일정 시간 뒤에 다시 시도할 수 있는 에러 (Retry-After 헤더로 전달)
+type RetryError struct {
+	Err   error
+	After time.Duration
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%v: retry after %s", e.Err, e.After.Round(time.Second))
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+const (
+	exportTimeout  = 10 * time.Minute // 이보다 오래된 대기/진행 중 작업은 중단된 것으로 간주
+	exportDataFile = "user-data.json"
+)
+
+// WithExports 데이터 내보내기 저장소와 결과 파일 보관 기간 설정
+func (s *UserService) WithExports(exports repository.ExportRepository, retention time.Duration) *UserService {
+	s.exports = exports
+	if retention > 0 {
+		s.exportRetention = retention
+	}
+	return s
+}
+
+// RequestExport 로그인 사용자의 데이터 내보내기 작업 생성 후 비동기로 실행
+// 이미 진행 중인 작업이 있으면 ErrExportInProgress 반환
+func (s *UserService) RequestExport(ctx context.Context, authID primitive.ObjectID, format string) (*models.ExportJob, error) {
+	if s.exports == nil {
+		return nil, ErrExportDisabled
+	}
+
+	switch format {
+	case "":
+		format = models.ExportFormatJSON
+	case models.ExportFormatJSON, models.ExportFormatZip:
+	default:
+		var violations fieldErrors
+		violations.add("format", utils.ViolationInvalidValue, map[string]string{"value": format}, "unsupported export format: %s", format)
+		return nil, violations.err()
+	}
+
+	if _, err := s.getProfileByAuthID(ctx, authID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.exports.AbandonStaleExports(ctx, authID, now.Add(-exportTimeout)); err != nil {
+		return nil, err
+	}
+
+	job := &models.ExportJob{
+		AuthID:    authID,
+		Format:    format,
+		Status:    models.ExportPending,
+		CreatedAt: now,
+	}
+	if err := s.exports.CreateExport(ctx, job); err != nil {
+		return nil, err
+	}
+
+	// 요청이 끝나도 작업은 계속되도록 별도 컨텍스트에서 실행
+	go s.runExport(*job)
+
+	return job, nil
+}
+
+// GetExport 로그인 사용자의 내보내기 작업 조회 (다른 사용자의 작업은 없는 것으로 처리)
+func (s *UserService) GetExport(ctx context.Context, authID, exportID primitive.ObjectID) (*models.ExportJob, error) {
+	if s.exports == nil {
+		return nil, ErrExportNotFound
+	}
+
+	job, err := s.exports.GetExport(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil || job.AuthID != authID {
+		return nil, ErrExportNotFound
+	}
+	if job.ExpiresAt != nil && !job.ExpiresAt.After(time.Now()) {
+		return nil, ErrExportNotFound
+	}
+	return job, nil
+}
+
+// DownloadExport 완료된 내보내기 결과 파일 조회
+func (s *UserService) DownloadExport(ctx context.Context, authID, exportID primitive.ObjectID) (*models.ExportJob, error) {
+	job, err := s.GetExport(ctx, authID, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != models.ExportCompleted {
+		return nil, ErrExportNotReady
+	}
+	return job, nil
+}
+
+// DeleteExpiredExports 보관 기간이 지난 내보내기 결과 삭제 (백그라운드 작업)
+func (s *UserService) DeleteExpiredExports(ctx context.Context) error {
+	if s.exports == nil {
+		return nil
+	}
+
+	deleted, err := s.exports.DeleteExpiredExports(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Printf("Deleted %d expired data exports", deleted)
+	}
+	return nil
+}
+
+// runExport 내보내기 파일을 생성해 작업에 저장
+func (s *UserService) runExport(job models.ExportJob) {
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	job.Status = models.ExportRunning
+	if err := s.exports.UpdateExport(ctx, &job); err != nil {
+		log.Printf("Failed to start export %s: %v", job.ID.Hex(), err)
+		return
+	}
+
+	data, err := s.buildExport(ctx, job.AuthID, job.Format)
+	now := time.Now()
+	job.CompletedAt = &now
+	if err != nil {
+		log.Printf("Export %s failed: %v", job.ID.Hex(), err)
+		job.Status = models.ExportFailed
+		job.Error = "export generation failed"
+	} else {
+		expiresAt := now.Add(s.exportRetention)
+		job.Status = models.ExportCompleted
+		job.ExpiresAt = &expiresAt
+		job.Data = data
+		job.Size = int64(len(data))
+		job.FileName, job.ContentType = exportFileName(job.ID, job.Format)
+	}
+
+	if err := s.exports.UpdateExport(ctx, &job); err != nil {
+		log.Printf("Failed to save export %s: %v", job.ID.Hex(), err)
+	}
+}
+
+// buildExport 사용자 데이터 전체를 요청된 형식으로 직렬화
+func (s *UserService) buildExport(ctx context.Context, authID primitive.ObjectID, format string) ([]byte, error) {
+	profile, err := s.getProfileByAuthID(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &models.UserDataExport{
+		ExportedAt: time.Now(),
+		Profile:    models.NewProfileResponse(profile, models.ViewOwner),
+		Account: models.ExportAccount{
+			Status:          profile.Status,
+			StatusReason:    profile.StatusReason,
+			StatusChangedAt: profile.StatusChangedAt,
+			SuspendedUntil:  profile.SuspendedUntil,
+			ErasedAt:        profile.ErasedAt,
+		},
+		Privacy:       profile.Privacy,
+		Addresses:     exportAddresses(profile.Addresses),
+		StatusHistory: exportStatusHistory(profile.StatusHistory),
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if format != models.ExportFormatZip {
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	file, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     exportDataFile,
+		Method:   zip.Deflate,
+		Modified: export.ExportedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Write(data); err != nil {
+		return nil, err
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// exportStatusHistory 상태 변경 이력 복사 (처리한 관리자 ID는 제외)
+func exportStatusHistory(history []*models.StatusChange) []*models.StatusChange {
+	entries := make([]*models.StatusChange, 0, len(history))
+	for _, change := range history {
+		entry := *change
+		if entry.ActorType == models.ActorAdmin {
+			entry.ActorID = ""
+		}
+		entries = append(entries, &entry)
+	}
+	return entries
+}
+
+func exportAddresses(addresses []*models.AddressEntry) []*models.AddressEntry {
+	if addresses == nil {
+		return []*models.AddressEntry{}
+	}
+	return addresses
+}
+
+func exportFileName(id primitive.ObjectID, format string) (name, contentType string) {
+	if format == models.ExportFormatZip {
+		return "user-data-" + id.Hex() + ".zip", "application/zip"
+	}
+	return "user-data-" + id.Hex() + ".json", "application/json"
+}
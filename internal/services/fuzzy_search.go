@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/search"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+const (
+	defaultFuzzyLimit   = 20
+	maxFuzzyLimit       = 50
+	maxFuzzyQueryLength = 100
+)
+
+// WithSearchIndex 오타 허용 검색 인덱스 설정 (프로필 변경 시 함께 갱신)
+func (s *UserService) WithSearchIndex(index *search.Index) *UserService {
+	s.searchIndex = index
+	return s
+}
+
+// FuzzySearchProfiles 오타를 허용하는 프로필 검색 (username과 공개된 이름 대상)
+// 유사도 순으로 정렬하며 일치한 단어 위치를 함께 반환
+func (s *UserService) FuzzySearchProfiles(ctx context.Context, query string, limit int64) (*models.FuzzySearchResponse, error) {
+	if s.searchIndex == nil {
+		return nil, ErrSearchIndexDisabled
+	}
+
+	query = strings.TrimSpace(query)
+	var violations fieldErrors
+	switch length := utf8.RuneCountInString(query); {
+	case length < minSearchQueryLength:
+		violations.add("q", utils.ViolationTooShort, map[string]string{"min": strconv.Itoa(minSearchQueryLength)},
+			"search query must be at least %d characters", minSearchQueryLength)
+	case length > maxFuzzyQueryLength:
+		violations.add("q", utils.ViolationTooLong, map[string]string{"max": strconv.Itoa(maxFuzzyQueryLength)},
+			"search query must be at most %d characters", maxFuzzyQueryLength)
+	}
+	if limit == 0 {
+		limit = defaultFuzzyLimit
+	}
+	if limit > maxFuzzyLimit {
+		violations.add("limit", utils.ViolationTooLarge, map[string]string{"max": strconv.Itoa(maxFuzzyLimit)},
+			"limit must not exceed %d", maxFuzzyLimit)
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	hits := s.searchIndex.Search(query, int(limit))
+	response := &models.FuzzySearchResponse{Items: make([]models.FuzzySearchItem, 0, len(hits))}
+	for _, hit := range hits {
+		response.Items = append(response.Items, models.FuzzySearchItem{
+			ID:          hit.ID,
+			Username:    hit.Username,
+			DisplayName: strings.TrimSpace(hit.FirstName + " " + hit.LastName),
+			Score:       hit.Score,
+			Highlights:  hit.Highlights,
+		})
+	}
+	return response, nil
+}
+
+// RebuildSearchIndex 저장소의 active 프로필로 이 인스턴스의 검색 인덱스 전체 재구성
+// 여러 인스턴스를 띄우면 다른 인스턴스의 변경은 재구성 때 반영되며, 다른 인스턴스의 인덱스는 각자의 주기에 재구성됨
+func (s *UserService) RebuildSearchIndex(ctx context.Context) (*models.SearchIndexReport, error) {
+	if s.searchIndex == nil {
+		return nil, ErrSearchIndexDisabled
+	}
+
+	started := time.Now()
+	filter := models.ProfileFilter{Status: models.StatusActive}
+	stats, err := s.searchIndex.Rebuild(ctx, func(ctx context.Context, fn func(*models.UserProfile) error) error {
+		return s.repo.ScanProfiles(ctx, filter, fn)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	took := time.Since(started)
+	log.Printf("Search index rebuilt: %d profiles in %v", stats.Documents, took)
+	return &models.SearchIndexReport{
+		Documents: stats.Documents,
+		Trigrams:  stats.Trigrams,
+		BuiltAt:   stats.BuiltAt,
+		Took:      took.Round(time.Microsecond).String(),
+		Scope:     models.SearchIndexScopeInstance,
+		Instance:  instanceName(),
+	}, nil
+}
+
+// instanceName 응답에 표시할 현재 인스턴스 이름 (호스트 이름, 실패하면 프로세스 ID)
+func instanceName() string {
+	if host, err := os.Hostname(); err == nil && host != "" {
+		return host
+	}
+	return "pid-" + strconv.Itoa(os.Getpid())
+}
+
+// RefreshSearchIndex 주기적 재구성용 (백그라운드 작업)
+func (s *UserService) RefreshSearchIndex(ctx context.Context) error {
+	_, err := s.RebuildSearchIndex(ctx)
+	return err
+}
+
+// indexProfile 변경된 프로필을 검색 인덱스에 반영 (active가 아니면 제거)
+func (s *UserService) indexProfile(profile *models.UserProfile) {
+	if s.searchIndex != nil {
+		s.searchIndex.Put(profile)
+	}
+}
+
+// reindexProfile 저장된 프로필을 다시 읽어 검색 인덱스에 반영
+// 실패해도 변경 요청은 성공으로 처리하고 다음 재구성 때 바로잡음
+func (s *UserService) reindexProfile(ctx context.Context, id primitive.ObjectID) {
+	if s.searchIndex == nil {
+		return
+	}
+	profile, err := s.repo.GetProfileByID(ctx, id)
+	if err != nil {
+		log.Printf("Failed to reindex profile %s: %v", id.Hex(), err)
+		return
+	}
+	if profile == nil {
+		s.searchIndex.Remove(id)
+		return
+	}
+	s.searchIndex.Put(profile)
+}
+
+func (s *UserService) unindexProfile(id primitive.ObjectID) {
+	if s.searchIndex != nil {
+		s.searchIndex.Remove(id)
+	}
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"log"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+const (
+	defaultNearbyRadiusKm = 10
+	maxNearbyRadiusKm     = 100
+	defaultNearbyLimit    = 20
+	maxNearbyLimit        = 100
+)
+
+// Geocoder 주소를 좌표로 변환 (찾지 못하면 nil, nil 반환)
+type Geocoder interface {
+	Geocode(ctx context.Context, addr models.Address) (*models.GeoPoint, error)
+}
+
+// WithGeocoder 좌표 없이 저장되는 주소에 사용할 지오코더 설정 (nil이면 지오코딩 비활성)
+func (s *UserService) WithGeocoder(geocoder Geocoder) *UserService {
+	s.geocoder = geocoder
+	return s
+}
+
+// locate 주소에 좌표가 없으면 지오코딩으로 채움
+// 지오코딩 실패는 주소 저장을 막지 않고 좌표 없이 저장
+func (s *UserService) locate(ctx context.Context, addr *models.Address) {
+	if addr == nil || addr.Location != nil || s.geocoder == nil {
+		return
+	}
+	point, err := s.geocoder.Geocode(ctx, *addr)
+	if err != nil {
+		log.Printf("Failed to geocode %s, %s: %v", addr.City, addr.Country, err)
+		return
+	}
+	addr.Location = point
+}
+
+// relocateAddress 주소 전체 교체 시 좌표 결정
+// 지역이 바뀌었는데 이전 주소의 좌표를 그대로 보냈으면 새 주소로 다시 지오코딩 (새로 지정한 좌표는 유지)
+func (s *UserService) relocateAddress(ctx context.Context, addr *models.Address, previous models.Address) {
+	if addr == nil {
+		return
+	}
+	if addr.Location != nil && localityChanged(previous, *addr) && sameLocation(addr.Location, previous.Location) {
+		addr.Location = nil
+	}
+	s.locate(ctx, addr)
+}
+
+// localityChanged 좌표에 영향을 주는 주소 필드가 바뀌었는지 확인 (도로명은 제외, localityPaths와 같은 기준)
+func localityChanged(a, b models.Address) bool {
+	return !strings.EqualFold(a.City, b.City) ||
+		!strings.EqualFold(a.State, b.State) ||
+		!strings.EqualFold(a.PostalCode, b.PostalCode) ||
+		!strings.EqualFold(a.Country, b.Country)
+}
+
+// sameLocation 두 좌표가 같은지 확인
+func sameLocation(a, b *models.GeoPoint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return slices.Equal(a.Coordinates, b.Coordinates)
+}
+
+// NearbyProfiles 기준 지점에서 반경 안에 있는 active 프로필을 가까운 순으로 조회
+// 위치 공개(show_location) 설정한 프로필만 대상이며, 필드는 조회자 권한에 따라 제한
+func (s *UserService) NearbyProfiles(ctx context.Context, req models.NearbyRequest, viewer models.Viewer) (*models.NearbyResponse, error) {
+	var violations fieldErrors
+	if req.Lat == nil {
+		violations.add("lat", utils.ViolationRequired, nil, "lat is required")
+	} else if *req.Lat < -90 || *req.Lat > 90 {
+		violations.add("lat", utils.ViolationInvalidValue, nil, "lat must be between -90 and 90")
+	}
+	if req.Lng == nil {
+		violations.add("lng", utils.ViolationRequired, nil, "lng is required")
+	} else if *req.Lng < -180 || *req.Lng > 180 {
+		violations.add("lng", utils.ViolationInvalidValue, nil, "lng must be between -180 and 180")
+	}
+	if req.RadiusKm == 0 {
+		req.RadiusKm = defaultNearbyRadiusKm
+	}
+	if req.RadiusKm > maxNearbyRadiusKm {
+		violations.add("radius_km", utils.ViolationTooLarge, map[string]string{"max": strconv.Itoa(maxNearbyRadiusKm)},
+			"radius_km must not exceed %d", maxNearbyRadiusKm)
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultNearbyLimit
+	}
+	if req.Limit > maxNearbyLimit {
+		violations.add("limit", utils.ViolationTooLarge, map[string]string{"max": strconv.Itoa(maxNearbyLimit)},
+			"limit must not exceed %d", maxNearbyLimit)
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	results, err := s.repo.FindNearbyProfiles(ctx, models.NearbyQuery{
+		Point:        models.NewGeoPoint(*req.Lat, *req.Lng),
+		RadiusMeters: req.RadiusKm * 1000,
+		Limit:        req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.NearbyResponse{Items: make([]models.NearbyItem, 0, len(results))}
+	for _, result := range results {
+		response.Items = append(response.Items, models.NearbyItem{
+			ProfileResponse: models.NewProfileResponse(result.Profile, viewer.ViewOf(result.Profile)),
+			DistanceKm:      math.Round(result.DistanceMeters/100) / 10,
+		})
+	}
+	return response, nil
+}
+
+// validateLocation 좌표 형식 검사 (위반 사항은 address.location으로 기록)
+func validateLocation(violations *fieldErrors, point *models.GeoPoint) {
+	if point != nil && !point.Valid() {
+		violations.add("address.location", utils.ViolationInvalidValue, nil,
+			"location must be a GeoJSON Point with [longitude, latitude] coordinates")
+	}
+}
@@ -0,0 +1,461 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/phone"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// patchField 패치로 수정 가능한 필드 (경로는 JSON, BSON 필드명이 같아 그대로 사용)
+type patchField struct {
+	path      string
+	isBool    bool
+	isPoint   bool                                        // GeoJSON Point 객체
+	removable bool                                        // 값을 지워 $unset 할 수 있는지 여부
+	rule      func(*utils.ValidationRules) utils.TextRule // nil이면 형식 검사 없음
+}
+
+// patchFields 패치 가능한 필드 목록 (address, privacy는 하위 필드 단위)
+var patchFields = []patchField{
+	{path: "username", rule: usernameRule},
+	{path: "first_name", rule: nameRule},
+	{path: "last_name", rule: nameRule},
+	{path: "phone_number", removable: true}, // patchPhone에서 주소 국가 기준으로 정규화
+	{path: "address.street", removable: true},
+	{path: "address.city", removable: true},
+	{path: "address.state", removable: true},
+	{path: "address.postal_code", removable: true},
+	{path: "address.country", removable: true},
+	{path: "address.location", removable: true, isPoint: true},
+	{path: "privacy.show_name", isBool: true},
+	{path: "privacy.show_email", isBool: true},
+	{path: "privacy.show_phone_number", isBool: true},
+	{path: "privacy.show_street", isBool: true},
+	{path: "privacy.show_city", isBool: true},
+	{path: "privacy.show_state", isBool: true},
+	{path: "privacy.show_postal_code", isBool: true},
+	{path: "privacy.show_country", isBool: true},
+	{path: "privacy.show_location", isBool: true},
+}
+
+func usernameRule(r *utils.ValidationRules) utils.TextRule { return r.Username }
+func nameRule(r *utils.ValidationRules) utils.TextRule     { return r.Name }
+
+// patchObjects 하위 필드를 가진 객체 필드
+var patchObjects = map[string]bool{"address": true, "privacy": true}
+
+// PatchProfile JSON Merge Patch 또는 JSON Patch로 프로필 부분 수정 (소유자만 가능)
+func (s *UserService) PatchProfile(ctx context.Context, userID primitive.ObjectID, viewer models.Viewer, patch *models.ProfilePatch, pre models.Precondition) error {
+	profile, err := s.getProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if viewer.ViewOf(profile) != models.ViewOwner {
+		return ErrNotProfileOwner
+	}
+
+	return s.patchProfile(ctx, profile, patch, pre)
+}
+
+// PatchProfileByAuthID 로그인 사용자의 프로필 부분 수정 (/users/me)
+func (s *UserService) PatchProfileByAuthID(ctx context.Context, authID primitive.ObjectID, patch *models.ProfilePatch, pre models.Precondition) error {
+	profile, err := s.getProfileByAuthID(ctx, authID)
+	if err != nil {
+		return err
+	}
+
+	return s.patchProfile(ctx, profile, patch, pre)
+}
+
+func (s *UserService) patchProfile(ctx context.Context, profile *models.UserProfile, patch *models.ProfilePatch, pre models.Precondition) error {
+	if !pre.Matches(profile.Version) {
+		return ErrPreconditionFailed
+	}
+	if !isEditable(profile.Status) {
+		return fmt.Errorf("%w while %s", ErrProfileNotEditable, profile.Status)
+	}
+
+	original, err := toPatchDocument(models.NewProfilePatchDocument(profile))
+	if err != nil {
+		return err
+	}
+
+	patched, err := applyPatch(original, patch)
+	if err != nil {
+		return err
+	}
+	patchedDoc, ok := patched.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%w: patched profile must be a JSON object", ErrInvalidPatch)
+	}
+
+	update, err := s.patchUpdate(ctx, profile, original, patchedDoc)
+	if err != nil {
+		return err
+	}
+	if len(update.Set) == 0 && len(update.Unset) == 0 {
+		return nil // 변경된 내용이 없음
+	}
+
+	return s.applyUpdate(ctx, profile, update, pre)
+}
+
+// applyPatch Content-Type에 맞는 패치 형식으로 문서에 적용
+func applyPatch(doc map[string]interface{}, patch *models.ProfilePatch) (interface{}, error) {
+	switch patch.ContentType {
+	case utils.MergePatchContentType:
+		var mergePatch interface{}
+		if err := json.Unmarshal(patch.Body, &mergePatch); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+		}
+		if _, ok := mergePatch.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("%w: merge patch must be a JSON object", ErrInvalidPatch)
+		}
+		return utils.ApplyMergePatch(doc, mergePatch), nil
+	case utils.JSONPatchContentType:
+		var ops []utils.PatchOperation
+		if err := json.Unmarshal(patch.Body, &ops); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+		}
+		patched, err := utils.ApplyJSONPatch(doc, ops)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+		}
+		return patched, nil
+	default:
+		return nil, ErrUnsupportedPatch
+	}
+}
+
+// patchUpdate 패치 전후 문서를 필드별로 비교해 검증 후 $set/$unset 생성
+// 검증 실패는 필드별로 모두 모아 ValidationError로 반환
+func (s *UserService) patchUpdate(ctx context.Context, profile *models.UserProfile, before, after map[string]interface{}) (repository.ProfileUpdate, error) {
+	update := repository.ProfileUpdate{Set: bson.M{}}
+	var violations fieldErrors
+
+	oldValues := flattenPatchDocument(before, &violations)
+	newValues := flattenPatchDocument(after, &violations)
+
+	addressChanged := false
+	for _, field := range patchFields {
+		oldValue, hadOld := oldValues[field.path]
+		newValue, hasNew := newValues[field.path]
+
+		if !hasNew {
+			if !hadOld {
+				continue
+			}
+			if !field.removable {
+				violations.add(field.path, utils.ViolationNotRemovable, nil, "%s cannot be removed", field.path)
+				continue
+			}
+			update.Unset = append(update.Unset, field.path)
+			addressChanged = addressChanged || strings.HasPrefix(field.path, "address.")
+			continue
+		}
+
+		if field.isPoint {
+			point, ok := decodeGeoPoint(newValue)
+			if !ok {
+				violations.add(field.path, utils.ViolationInvalidValue, nil,
+					"%s must be a GeoJSON Point with [longitude, latitude] coordinates", field.path)
+				continue
+			}
+			if old, _ := decodeGeoPoint(oldValue); hadOld && point.Equal(old) {
+				continue
+			}
+			update.Set[field.path] = point
+			addressChanged = true
+			continue
+		}
+		if hadOld && oldValue == newValue {
+			continue
+		}
+
+		if field.isBool {
+			if _, ok := newValue.(bool); !ok {
+				violations.add(field.path, utils.ViolationInvalidType, map[string]string{"type": "boolean"}, "%s must be a boolean", field.path)
+				continue
+			}
+			update.Set[field.path] = newValue
+			continue
+		}
+
+		str, ok := newValue.(string)
+		if !ok {
+			violations.add(field.path, utils.ViolationInvalidType, map[string]string{"type": "string"}, "%s must be a string", field.path)
+			continue
+		}
+		if field.rule != nil {
+			str = violations.apply(field.path, field.rule(s.rules), str)
+			if hadOld && oldValue == str {
+				continue // 정규화하면 기존 값과 같음
+			}
+		}
+		update.Set[field.path] = str
+		addressChanged = addressChanged || strings.HasPrefix(field.path, "address.")
+	}
+
+	var address *models.Address // 패치 후 주소 (주소를 지우면 nil)
+	if addressChanged {
+		patched := patchedAddress(newValues)
+		if patched == (models.Address{}) {
+			// 주소 전체 삭제는 하위 필드 대신 address 필드를 제거
+			update = withoutAddress(update)
+			update.Unset = append(update.Unset, "address")
+		} else {
+			validateAddress(&violations, &patched)
+			s.relocate(ctx, &update, patched)
+			setNormalizedAddress(&update, newValues, patched)
+			patched.Location = patchedLocation(update, newValues)
+			address = &patched
+		}
+	}
+	number := s.patchPhone(&update, newValues, profile, &violations)
+	if err := violations.err(); err != nil {
+		return update, err
+	}
+	if addressChanged {
+		if err := routeLegacyAddress(profile, &update, address); err != nil {
+			return update, err
+		}
+	}
+
+	if username, ok := update.Set["username"].(string); ok {
+		if err := s.checkUsernameAvailable(ctx, username, profile.ID); err != nil {
+			return update, err
+		}
+	}
+	if number != nil {
+		if err := s.checkPhoneAvailable(ctx, number.E164, profile.ID); err != nil {
+			return update, err
+		}
+	}
+
+	return update, nil
+}
+
+// flattenPatchDocument 문서를 "address.city" 형태의 경로별 값으로 변환
+// 알 수 없는 필드나 객체가 아닌 address/privacy는 위반으로 기록, null 값은 없는 필드로 처리
+func flattenPatchDocument(doc map[string]interface{}, violations *fieldErrors) map[string]interface{} {
+	allowed := make(map[string]bool, len(patchFields))
+	for _, field := range patchFields {
+		allowed[field.path] = true
+	}
+
+	values := map[string]interface{}{}
+	for _, key := range sortedKeys(doc) {
+		value := doc[key]
+		if value == nil {
+			continue
+		}
+
+		if !patchObjects[key] {
+			if !allowed[key] {
+				violations.add(key, utils.ViolationUnknownField, nil, "unknown field: %s", key)
+				continue
+			}
+			values[key] = value
+			continue
+		}
+
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			violations.add(key, utils.ViolationInvalidType, map[string]string{"type": "object"}, "%s must be an object", key)
+			continue
+		}
+		for _, subKey := range sortedKeys(obj) {
+			path := key + "." + subKey
+			if !allowed[path] {
+				violations.add(path, utils.ViolationUnknownField, nil, "unknown field: %s", path)
+				continue
+			}
+			if obj[subKey] != nil {
+				values[path] = obj[subKey]
+			}
+		}
+	}
+	return values
+}
+
+// localityPaths 바뀌면 좌표를 다시 구해야 하는 주소 필드 (도로명은 포함하지 않음)
+var localityPaths = []string{"address.city", "address.state", "address.postal_code", "address.country"}
+
+// relocate 지역이 바뀌었는데 좌표는 그대로면 새 주소로 다시 지오코딩 (찾지 못하면 좌표 제거)
+func (s *UserService) relocate(ctx context.Context, update *repository.ProfileUpdate, address models.Address) {
+	if touches(*update, "address.location") {
+		return
+	}
+	moved := false
+	for _, path := range localityPaths {
+		moved = moved || touches(*update, path)
+	}
+	if !moved {
+		return
+	}
+
+	address.Location = nil
+	s.locate(ctx, &address)
+	if address.Location != nil {
+		update.Set["address.location"] = address.Location
+	} else {
+		update.Unset = append(update.Unset, "address.location")
+	}
+}
+
+// touches 업데이트가 path를 설정하거나 제거하는지 확인
+func touches(update repository.ProfileUpdate, path string) bool {
+	if _, ok := update.Set[path]; ok {
+		return true
+	}
+	for _, unset := range update.Unset {
+		if unset == path {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeGeoPoint 패치 문서의 JSON 값을 GeoJSON Point로 변환
+func decodeGeoPoint(value interface{}) (*models.GeoPoint, bool) {
+	if value == nil {
+		return nil, false
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+	var point models.GeoPoint
+	if err := json.Unmarshal(data, &point); err != nil || !point.Valid() {
+		return nil, false
+	}
+	return &point, true
+}
+
+func patchedAddress(values map[string]interface{}) models.Address {
+	get := func(path string) string {
+		str, _ := values[path].(string)
+		return str
+	}
+	return models.Address{
+		Street:     get("address.street"),
+		City:       get("address.city"),
+		State:      get("address.state"),
+		PostalCode: get("address.postal_code"),
+		Country:    get("address.country"),
+	}
+}
+
+// patchedLocation 업데이트를 적용한 뒤의 주소 좌표 (relocate로 다시 구한 좌표 포함)
+func patchedLocation(update repository.ProfileUpdate, values map[string]interface{}) *models.GeoPoint {
+	if point, ok := update.Set["address.location"].(*models.GeoPoint); ok {
+		return point
+	}
+	if touches(update, "address.location") {
+		return nil
+	}
+	point, _ := decodeGeoPoint(values["address.location"])
+	return point
+}
+
+// patchPhone 바뀐 전화번호를 패치 후 주소 국가 기준으로 정규화해 표시 값과 E.164 값을 함께 설정
+// 정규화한 번호가 기존 번호와 같으면 변경에서 제외하고, 번호를 지우거나 바꾸면 E.164 값과 인증 시각도 제거
+func (s *UserService) patchPhone(update *repository.ProfileUpdate, values map[string]interface{}, profile *models.UserProfile, violations *fieldErrors) *phone.Number {
+	for _, path := range update.Unset {
+		if path == "phone_number" {
+			update.Unset = append(update.Unset, "phone_e164", "phone_verified_at")
+			return nil
+		}
+	}
+	value, ok := update.Set["phone_number"].(string)
+	if !ok {
+		return nil
+	}
+
+	country, _ := values["address.country"].(string)
+	number := s.normalizePhone(violations, value, country)
+	switch {
+	case number == nil:
+		return nil
+	case number.E164 == profile.PhoneE164 && number.String() == profile.PhoneNumber:
+		delete(update.Set, "phone_number")
+		return nil
+	}
+	update.Set["phone_number"] = number.String()
+	update.Set["phone_e164"] = number.E164
+	if number.E164 != profile.PhoneE164 {
+		update.Unset = append(update.Unset, "phone_verified_at")
+	}
+	return number
+}
+
+// setNormalizedAddress 국가 규칙으로 정규화되어 값이 바뀐 주소 필드를 업데이트에 반영 (예: "korea" → "KR")
+func setNormalizedAddress(update *repository.ProfileUpdate, values map[string]interface{}, address models.Address) {
+	normalized := map[string]string{
+		"address.street":      address.Street,
+		"address.city":        address.City,
+		"address.state":       address.State,
+		"address.postal_code": address.PostalCode,
+		"address.country":     address.Country,
+	}
+	for path, value := range normalized {
+		old, _ := values[path].(string)
+		switch {
+		case old == value:
+		case value == "":
+			// 공백만 있던 선택 필드는 제거
+			delete(update.Set, path)
+			update.Unset = append(update.Unset, path)
+		default:
+			update.Set[path] = value
+		}
+	}
+}
+
+func withoutAddress(update repository.ProfileUpdate) repository.ProfileUpdate {
+	for path := range update.Set {
+		if strings.HasPrefix(path, "address.") {
+			delete(update.Set, path)
+		}
+	}
+	unset := update.Unset[:0]
+	for _, path := range update.Unset {
+		if !strings.HasPrefix(path, "address.") {
+			unset = append(unset, path)
+		}
+	}
+	update.Unset = unset
+	return update
+}
+
+func toPatchDocument(doc *models.ProfilePatchDocument) (map[string]interface{}, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// sortedKeys 에러 메시지가 매번 같도록 키를 정렬해 순회
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/address"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/phone"
+)
+
+// normalizePhone 주소 국가를 기본 지역으로 전화번호를 E.164로 정규화 (위반 시 기록하고 nil 반환)
+func (s *UserService) normalizePhone(violations *fieldErrors, value, country string) *phone.Number {
+	value, err := s.rules.Phone.Apply(value)
+	if err != nil {
+		violations.addError("phone_number", err)
+		return nil
+	}
+	region, _ := address.ResolveCountry(country)
+	number, err := phone.Parse(value, region)
+	if err != nil {
+		violations.addError("phone_number", err)
+		return nil
+	}
+	return &number
+}
+
+// checkPhoneAvailable 다른 프로필이 같은 전화번호를 쓰는지 확인
+// 유예 기간이 지난 탈퇴 프로필이 쓰던 번호면 그 프로필을 먼저 파기
+func (s *UserService) checkPhoneAvailable(ctx context.Context, e164 string, selfID primitive.ObjectID) error {
+	existing, err := s.repo.GetProfileByPhone(ctx, e164)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.ID == selfID {
+		return nil
+	}
+	if !s.deletionExpired(existing, time.Now()) {
+		return ErrPhoneTaken
+	}
+
+	_, err = s.erase(ctx, existing, models.SystemActor, erasureScheduled)
+	return err
+}
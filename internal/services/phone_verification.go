@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+const (
+	verificationCodeDigits = 6
+	verificationWindow     = time.Hour      // 발송 횟수 제한을 계산하는 기간
+	verificationRetention  = 24 * time.Hour // 발송 기록 보관 기간
+)
+
+// SMSSender 문자 메시지 발송 (to는 E.164 번호)
+type SMSSender interface {
+	Send(ctx context.Context, to, message string) error
+}
+
+// VerificationPolicy 전화번호 인증번호 유효 시간과 발송 제한
+type VerificationPolicy struct {
+	CodeTTL        time.Duration // 인증번호 유효 시간
+	ResendInterval time.Duration // 같은 사용자가 다시 발송을 요청할 수 있는 최소 간격
+	MaxPerHour     int           // 사용자, 전화번호별 1시간 발송 한도
+	MaxAttempts    int           // 인증번호 하나로 확인할 수 있는 횟수
+}
+
+// DefaultVerificationPolicy 기본 인증번호 정책
+func DefaultVerificationPolicy() VerificationPolicy {
+	return VerificationPolicy{
+		CodeTTL:        10 * time.Minute,
+		ResendInterval: time.Minute,
+		MaxPerHour:     5,
+		MaxAttempts:    5,
+	}
+}
+
+// WithPhoneVerification 인증번호 발송 기록 저장소, 문자 발송기, 정책 설정 (0인 정책 값은 기본값 사용)
+func (s *UserService) WithPhoneVerification(verifications repository.VerificationRepository, sender SMSSender, policy VerificationPolicy) *UserService {
+	defaults := DefaultVerificationPolicy()
+	if policy.CodeTTL <= 0 {
+		policy.CodeTTL = defaults.CodeTTL
+	}
+	if policy.ResendInterval <= 0 {
+		policy.ResendInterval = defaults.ResendInterval
+	}
+	if policy.MaxPerHour <= 0 {
+		policy.MaxPerHour = defaults.MaxPerHour
+	}
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaults.MaxAttempts
+	}
+	s.verifications = verifications
+	s.smsSender = sender
+	s.verificationPolicy = policy
+	return s
+}
+
+// RequestPhoneVerification 로그인 사용자의 전화번호로 인증번호 발송
+// 재발송 간격과 사용자, 전화번호별 시간당 한도를 넘으면 RetryError로 감싼 ErrVerificationRateLimited 반환
+func (s *UserService) RequestPhoneVerification(ctx context.Context, authID primitive.ObjectID) (*models.PhoneVerificationResponse, error) {
+	if s.verifications == nil || s.smsSender == nil {
+		return nil, ErrVerificationDisabled
+	}
+	profile, err := s.verifiableProfile(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+	policy := s.verificationPolicy
+	now := time.Now()
+
+	code, err := newVerificationCode()
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	verification := &models.PhoneVerification{
+		AuthID:    authID,
+		PhoneE164: profile.PhoneE164,
+		Salt:      hex.EncodeToString(salt),
+		CreatedAt: now,
+		ExpiresAt: now.Add(policy.CodeTTL),
+	}
+	verification.CodeHash = hashVerificationCode(verification, code)
+
+	// 발송 자리를 먼저 예약해 동시 요청이 함께 한도를 넘지 않도록 함 (발송에 실패해도 한도에 포함)
+	byUser := models.SendLimit{Window: verificationWindow, Max: policy.MaxPerHour, Interval: policy.ResendInterval}
+	byPhone := models.SendLimit{Window: verificationWindow, Max: policy.MaxPerHour}
+	reserved, retryAt, err := s.verifications.ReserveSend(ctx, authID, profile.PhoneE164, byUser, byPhone, now)
+	if err != nil {
+		return nil, err
+	}
+	if !reserved {
+		return nil, &RetryError{Err: ErrVerificationRateLimited, After: max(retryAt.Sub(now), time.Second)}
+	}
+	if err := s.verifications.CreateVerification(ctx, verification); err != nil {
+		return nil, err
+	}
+	if err := s.smsSender.Send(ctx, profile.PhoneE164, verificationMessage(profile.PhoneE164, code, policy.CodeTTL)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSMSDeliveryFailed, err)
+	}
+
+	return &models.PhoneVerificationResponse{
+		PhoneNumber: maskPhoneNumber(profile.PhoneNumber),
+		ExpiresAt:   verification.ExpiresAt,
+		ResendAfter: now.Add(policy.ResendInterval),
+	}, nil
+}
+
+// ConfirmPhoneVerification 인증번호를 확인하고 전화번호를 인증 완료로 표시
+// 가장 최근에 발송한 인증번호만 유효하며, 확인 횟수를 넘으면 새 인증번호를 받아야 함
+func (s *UserService) ConfirmPhoneVerification(ctx context.Context, authID primitive.ObjectID, req *models.ConfirmPhoneRequest) error {
+	if s.verifications == nil || s.smsSender == nil {
+		return ErrVerificationDisabled
+	}
+
+	var violations fieldErrors
+	code := strings.TrimSpace(req.Code)
+	if code == "" {
+		violations.add("code", utils.ViolationRequired, nil, "code is required")
+	} else if !isVerificationCode(code) {
+		violations.add("code", utils.ViolationInvalidFormat, map[string]string{"digits": fmt.Sprint(verificationCodeDigits)},
+			"code must be %d digits", verificationCodeDigits)
+	}
+	if err := violations.err(); err != nil {
+		return err
+	}
+
+	profile, err := s.verifiableProfile(ctx, authID)
+	if err != nil {
+		return err
+	}
+	latest, err := s.verifications.GetLatestVerification(ctx, authID)
+	if err != nil {
+		return err
+	}
+	// 인증번호를 받은 뒤 전화번호를 바꿨으면 이전 번호의 인증번호는 사용할 수 없음
+	if latest == nil || latest.ConsumedAt != nil || latest.PhoneE164 != profile.PhoneE164 {
+		return ErrVerificationNotFound
+	}
+	now := time.Now()
+	if !now.Before(latest.ExpiresAt) {
+		return ErrVerificationExpired
+	}
+
+	maxAttempts := s.verificationPolicy.MaxAttempts
+	attempted, err := s.verifications.RecordAttempt(ctx, latest.ID)
+	if err != nil {
+		return err
+	}
+	if attempted.Attempts > maxAttempts {
+		return ErrVerificationAttempts
+	}
+	if !hmac.Equal([]byte(hashVerificationCode(attempted, code)), []byte(attempted.CodeHash)) {
+		return fmt.Errorf("%w (%d attempts left)", ErrVerificationCodeMismatch, maxAttempts-attempted.Attempts)
+	}
+
+	if err := s.verifications.ConsumeVerification(ctx, attempted.ID, now); err != nil {
+		return err
+	}
+	return s.applyUpdate(ctx, profile, repository.ProfileUpdate{Set: bson.M{"phone_verified_at": now}}, models.Precondition{})
+}
+
+// DeleteExpiredVerifications 보관 기간이 지난 인증번호 발송 기록 삭제 (백그라운드 작업)
+func (s *UserService) DeleteExpiredVerifications(ctx context.Context) error {
+	if s.verifications == nil {
+		return nil
+	}
+	_, err := s.verifications.DeleteVerificationsBefore(ctx, time.Now().Add(-verificationRetention))
+	return err
+}
+
+// verifiableProfile 인증할 전화번호가 있고 아직 인증하지 않은 수정 가능한 프로필 조회
+func (s *UserService) verifiableProfile(ctx context.Context, authID primitive.ObjectID) (*models.UserProfile, error) {
+	profile, err := s.editableProfileByAuthID(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+	if profile.PhoneE164 == "" {
+		return nil, ErrPhoneNumberMissing
+	}
+	if profile.PhoneVerifiedAt != nil {
+		return nil, ErrPhoneAlreadyVerified
+	}
+	return profile, nil
+}
+
+// newVerificationCode 암호학적 난수로 만든 숫자 인증번호
+func newVerificationCode() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < verificationCodeDigits; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", verificationCodeDigits, n), nil
+}
+
+// hashVerificationCode 기록의 솔트를 키로 전화번호와 인증번호의 HMAC-SHA256 계산
+func hashVerificationCode(verification *models.PhoneVerification, code string) string {
+	mac := hmac.New(sha256.New, []byte(verification.Salt))
+	mac.Write([]byte(verification.PhoneE164 + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func isVerificationCode(code string) bool {
+	if len(code) != verificationCodeDigits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// verificationMessage 인증번호 문자 내용 (한국 번호는 한국어)
+func verificationMessage(e164, code string, ttl time.Duration) string {
+	minutes := int(ttl.Minutes())
+	if strings.HasPrefix(e164, "+82") {
+		return fmt.Sprintf("[Prisma Market] 인증번호 [%s]를 %d분 안에 입력해 주세요.", code, minutes)
+	}
+	return fmt.Sprintf("[Prisma Market] Your verification code is %s. It expires in %d minutes.", code, minutes)
+}
+
+// maskPhoneNumber 국가 번호, 앞 두 자리, 끝 네 자리를 제외한 숫자를 가림 (+82 10-****-5678)
+func maskPhoneNumber(display string) string {
+	national := strings.IndexByte(display, ' ')
+	total := 0
+	for _, c := range display {
+		if c >= '0' && c <= '9' {
+			total++
+		}
+	}
+
+	var b strings.Builder
+	seen, nationalSeen := 0, 0
+	for i, c := range display {
+		if c < '0' || c > '9' {
+			b.WriteRune(c)
+			continue
+		}
+		seen++
+		if i > national {
+			nationalSeen++
+		}
+		if i < national || nationalSeen <= 2 || seen > total-4 {
+			b.WriteRune(c)
+		} else {
+			b.WriteByte('*')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+const minSearchQueryLength = 2
+
+// SearchProfiles 프로필 검색 (점수 순, next_cursor로 다음 페이지 조회)
+// 비관리자는 active 프로필만 검색하며, 주소 필터는 공개된 주소에만 적용하고 이름 비공개 프로필은 username으로만 검색
+func (s *UserService) SearchProfiles(ctx context.Context, req models.SearchRequest, viewer models.Viewer) (*models.SearchResponse, error) {
+	filter, opts, err := s.searchParams(req, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	// 한 건 더 조회해 다음 페이지가 있는지 확인
+	limit := opts.Limit
+	opts.Limit++
+	results, total, err := s.repo.SearchProfiles(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.SearchResponse{
+		Items:       make([]*models.ProfileResponse, 0, len(results)),
+		Total:       total,
+		TotalCapped: total >= repository.MaxSearchCount,
+	}
+	if int64(len(results)) > limit {
+		results = results[:limit]
+		response.NextCursor = encodeSearchCursor(results[limit-1].Cursor())
+	}
+
+	for _, result := range results {
+		response.Items = append(response.Items, models.NewProfileResponse(result.Profile, viewer.ViewOf(result.Profile)))
+	}
+
+	return response, nil
+}
+
+// searchParams 검색 요청 검증 후 저장소 조건으로 변환
+func (s *UserService) searchParams(req models.SearchRequest, viewer models.Viewer) (models.SearchFilter, models.SearchOptions, error) {
+	var violations fieldErrors
+	filter := models.SearchFilter{
+		Query:             strings.TrimSpace(req.Query),
+		Status:            req.Status,
+		City:              strings.TrimSpace(req.City),
+		Country:           strings.TrimSpace(req.Country),
+		PublicAddressOnly: !viewer.IsAdmin(),
+		PublicNameOnly:    !viewer.IsAdmin(),
+	}
+	if authID, err := primitive.ObjectIDFromHex(viewer.AuthID); err == nil {
+		filter.OwnerAuthID = &authID
+	}
+	opts := models.SearchOptions{Limit: req.Limit}
+
+	if len(filter.Query) < minSearchQueryLength {
+		violations.add("q", utils.ViolationTooShort, map[string]string{"min": strconv.Itoa(minSearchQueryLength)},
+			"search query must be at least %d characters", minSearchQueryLength)
+	}
+
+	switch _, known := statusTransitions[filter.Status]; {
+	case filter.Status == "":
+		filter.Status = models.StatusActive
+	case !known:
+		violations.add("status", utils.ViolationInvalidValue, map[string]string{"value": filter.Status}, "invalid status: %s", filter.Status)
+	case filter.Status != models.StatusActive && !viewer.IsAdmin():
+		violations.add("status", utils.ViolationInvalidValue, map[string]string{"value": filter.Status},
+			"only administrators can search %s profiles", filter.Status)
+	}
+
+	if opts.Limit == 0 {
+		opts.Limit = defaultPageSize
+	}
+	if opts.Limit > maxPageSize {
+		violations.add("limit", utils.ViolationTooLarge, map[string]string{"max": strconv.Itoa(maxPageSize)},
+			"limit must not exceed %d", maxPageSize)
+	}
+
+	if req.Cursor != "" {
+		cursor, err := decodeSearchCursor(req.Cursor)
+		if err != nil {
+			violations.add("cursor", utils.ViolationInvalidValue, nil, "invalid cursor")
+		}
+		opts.After = cursor
+	}
+
+	return filter, opts, violations.err()
+}
+
+// encodeSearchCursor 커서를 클라이언트에 넘길 불투명 문자열로 변환
+func encodeSearchCursor(cursor models.SearchCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeSearchCursor(token string) (*models.SearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	var cursor models.SearchCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
+)
+
+// statusTransitions 상태별로 전환 가능한 다음 상태 (erased는 최종 상태)
+var statusTransitions = map[string][]string{
+	models.StatusPendingVerification: {models.StatusActive, models.StatusInactive, models.StatusSuspended, models.StatusBanned, models.StatusErased},
+	models.StatusActive:              {models.StatusInactive, models.StatusSuspended, models.StatusBanned, models.StatusPendingVerification, models.StatusErased},
+	models.StatusInactive:            {models.StatusActive, models.StatusBanned, models.StatusErased},
+	models.StatusSuspended:           {models.StatusActive, models.StatusInactive, models.StatusBanned, models.StatusErased},
+	models.StatusBanned:              {models.StatusActive, models.StatusErased},
+	models.StatusErased:              {},
+}
+
+// canTransition 주체별 허용 전환 확인
+// 본인은 탈퇴와 복구만, 시스템은 정지 해제와 탈퇴 프로필 파기만 가능하며 관리자는 전환표의 모든 전환 가능
+func canTransition(actor models.Actor, from, to string) bool {
+	allowed := false
+	for _, next := range statusTransitions[from] {
+		if next == to {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return false
+	}
+
+	switch actor.Type {
+	case models.ActorAdmin:
+		return true
+	case models.ActorOwner:
+		if from == models.StatusInactive {
+			return to == models.StatusActive // 탈퇴 복구
+		}
+		return to == models.StatusInactive && (from == models.StatusActive || from == models.StatusPendingVerification)
+	case models.ActorSystem:
+		return (from == models.StatusSuspended && to == models.StatusActive) ||
+			(from == models.StatusInactive && to == models.StatusErased)
+	default:
+		return false
+	}
+}
+
+// isEditable 본인이 프로필을 수정할 수 있는 상태인지 확인
+func isEditable(status string) bool {
+	return status == models.StatusActive || status == models.StatusPendingVerification
+}
+
+// transition 상태 전환 규칙을 검사한 뒤 상태 변경과 이력 기록
+// 조회 이후 다른 요청이 프로필을 바꿨으면 ErrVersionConflict (상태를 바꿨으면 ErrStatusConflict) 반환
+func (s *UserService) transition(ctx context.Context, profile *models.UserProfile, to string, actor models.Actor, reason string, until *time.Time) error {
+	if !canTransition(actor, profile.Status, to) {
+		return fmt.Errorf("%w: %s cannot change status from %s to %s", ErrInvalidTransition, actor.Type, profile.Status, to)
+	}
+	if until != nil && to != models.StatusSuspended {
+		return fmt.Errorf("%w: until is only allowed when suspending a profile", ErrInvalidTransition)
+	}
+	if to == models.StatusErased {
+		return fmt.Errorf("%w: erasure must go through the erasure flow", ErrInvalidTransition)
+	}
+
+	change := &models.StatusChange{
+		From:      profile.Status,
+		To:        to,
+		Reason:    reason,
+		ActorType: actor.Type,
+		ActorID:   actor.ID,
+		Until:     until,
+		At:        time.Now(),
+	}
+	if err := s.repo.ChangeStatus(ctx, profile.ID, profile.Version, change); err != nil {
+		return err
+	}
+
+	// 같은 요청에서 이어지는 조건부 수정과 ETag가 저장된 버전과 맞도록 메모리의 프로필도 갱신
+	profile.Version++
+	profile.UpdatedAt = change.At
+	profile.Status = to
+	profile.StatusReason = reason
+	profile.StatusChangedAt = &change.At
+	profile.StatusChangedBy = actor.ID
+	profile.SuspendedUntil = until
+	profile.StatusHistory = append(profile.StatusHistory, change)
+	s.indexProfile(profile)
+	return nil
+}
+
+// expireSuspension 정지 기간이 지난 프로필을 활성 상태로 복구 (조회 시점 지연 처리)
+func (s *UserService) expireSuspension(ctx context.Context, profile *models.UserProfile) error {
+	if profile.Status != models.StatusSuspended || profile.SuspendedUntil == nil || profile.SuspendedUntil.After(time.Now()) {
+		return nil
+	}
+	return s.transition(ctx, profile, models.StatusActive, models.SystemActor, "suspension expired", nil)
+}
+
+// ExpireSuspensions 정지 기간이 지난 프로필을 일괄 복구 (백그라운드 작업)
+func (s *UserService) ExpireSuspensions(ctx context.Context) error {
+	profiles, err := s.repo.ListExpiredSuspensions(ctx, time.Now(), 100)
+	if err != nil {
+		return err
+	}
+
+	expired := 0
+	for _, profile := range profiles {
+		if err := s.expireSuspension(ctx, profile); err != nil {
+			log.Printf("Failed to expire suspension of profile %s: %v", profile.ID.Hex(), err)
+			continue
+		}
+		expired++
+	}
+	if expired > 0 {
+		log.Printf("Expired %d suspension(s)", expired)
+	}
+	return nil
+}
+
+// GetStatusHistory 관리자용 상태 변경 이력 조회 (최신순)
+func (s *UserService) GetStatusHistory(ctx context.Context, userID primitive.ObjectID) (*models.StatusHistoryResponse, error) {
+	profile, err := s.getProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*models.StatusChange, len(profile.StatusHistory))
+	for i, change := range profile.StatusHistory {
+		history[len(history)-1-i] = change
+	}
+
+	return &models.StatusHistoryResponse{
+		ProfileID: profile.ID.Hex(),
+		Status:    profile.Status,
+		History:   history,
+	}, nil
+}
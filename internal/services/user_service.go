@@ -3,231 +3,378 @@ package services
 import (
 	"context"
 	"errors"
-	"regexp"
-	"strings"
+	"fmt"
+	"time"
 
 	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/models"
-	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository/mongodb"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/repository"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/internal/search"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/address"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/phone"
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type UserService struct {
-	repo *mongodb.UserRepository
+	repo                repository.UserRepository
+	exports             repository.ExportRepository // nil이면 데이터 내보내기 비활성
+	deletionGracePeriod time.Duration               // 탈퇴 후 복구 가능 기간, 지나면 개인정보 파기
+	exportRetention     time.Duration               // 내보내기 결과 파일 보관 기간
+	rules               *utils.ValidationRules      // 이름, username, 전화번호 검증 규칙
+	searchIndex         *search.Index               // nil이면 오타 허용 검색 비활성
+	geocoder            Geocoder                    // nil이면 좌표를 직접 지정한 주소만 위치 검색 대상
+
+	// 전화번호 인증 (저장소나 발송기가 nil이면 비활성)
+	verifications      repository.VerificationRepository
+	smsSender          SMSSender
+	verificationPolicy VerificationPolicy
+
+	// 프로필 이미지 업로드 (저장소가 nil이면 비활성)
+	avatars      BlobStore
+	avatarPolicy AvatarPolicy
 }
 
-func NewUserService(repo *mongodb.UserRepository) *UserService {
+func NewUserService(repo repository.UserRepository) *UserService {
 	return &UserService{
-		repo: repo,
+		repo:                repo,
+		deletionGracePeriod: 30 * 24 * time.Hour,
+		exportRetention:     24 * time.Hour,
+		rules:               utils.DefaultValidationRules(),
 	}
 }
 
+// WithValidationRules 프로필 필드 검증 규칙 설정
+func (s *UserService) WithValidationRules(rules *utils.ValidationRules) *UserService {
+	s.rules = rules
+	return s
+}
+
+// WithDeletionGracePeriod 탈퇴 프로필 복구 가능 기간 설정
+func (s *UserService) WithDeletionGracePeriod(d time.Duration) *UserService {
+	s.deletionGracePeriod = d
+	return s
+}
+
 func (s *UserService) CreateProfile(ctx context.Context, authID primitive.ObjectID, email string, req *models.CreateProfileRequest) error {
 	// 입력값 검증
-	if err := validateCreateRequest(req); err != nil {
+	number, err := s.validateCreateRequest(req)
+	if err != nil {
 		return err
 	}
 
-	// username 중복 체크
-	existingProfile, err := s.repo.GetProfileByUsername(ctx, req.Username)
+	// 이미 프로필이 있는 계정인지 확인
+	existing, err := s.repo.GetProfileByAuthID(ctx, authID)
 	if err != nil {
 		return err
 	}
-	if existingProfile != nil {
-		return errors.New("username already exists")
+	if existing != nil {
+		return ErrProfileExists
+	}
+
+	// username, 전화번호 중복 체크
+	if err := s.checkUsernameAvailable(ctx, req.Username, primitive.NilObjectID); err != nil {
+		return err
+	}
+	if err := s.checkPhoneAvailable(ctx, number.E164, primitive.NilObjectID); err != nil {
+		return err
+	}
+
+	privacy := models.DefaultPrivacySettings()
+	if req.Privacy != nil {
+		privacy = *req.Privacy
 	}
+	s.locate(ctx, &req.Address)
 
 	// 프로필 생성
 	profile := &models.UserProfile{
 		AuthID:      authID,
 		Email:       email,
 		Username:    req.Username,
+		UsernameKey: utils.UsernameKey(req.Username),
 		FirstName:   req.FirstName,
 		LastName:    req.LastName,
-		PhoneNumber: req.PhoneNumber,
+		PhoneNumber: number.String(),
+		PhoneE164:   number.E164,
 		Address:     req.Address,
+		Privacy:     privacy,
 	}
+	profile.SearchKeys = models.BuildSearchKeys(profile)
 
-	return s.repo.CreateProfile(ctx, profile)
+	if err := s.repo.CreateProfile(ctx, profile); err != nil {
+		return err
+	}
+	s.indexProfile(profile)
+	return nil
 }
 
-func (s *UserService) GetProfile(ctx context.Context, userID primitive.ObjectID) (*models.ProfileResponse, error) {
-	profile, err := s.repo.GetProfileByID(ctx, userID)
+// GetProfile 프로필 조회 (조회자 권한에 따라 필드 제한)
+func (s *UserService) GetProfile(ctx context.Context, userID primitive.ObjectID, viewer models.Viewer) (*models.ProfileResponse, error) {
+	profile, err := s.getProfile(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
-	if profile == nil {
-		return nil, errors.New("profile not found")
+	view := viewer.ViewOf(profile)
+	if profile.Status == models.StatusErased && view == models.ViewPublic {
+		return nil, ErrProfileNotFound
 	}
 
-	return &models.ProfileResponse{
-		UserProfile: *profile,
-	}, nil
+	return models.NewProfileResponse(profile, view), nil
 }
 
-func (s *UserService) GetProfileByUsername(ctx context.Context, username string) (*models.ProfileResponse, error) {
+// GetProfileByUsername username으로 프로필 조회 (조회자 권한에 따라 필드 제한)
+func (s *UserService) GetProfileByUsername(ctx context.Context, username string, viewer models.Viewer) (*models.ProfileResponse, error) {
 	profile, err := s.repo.GetProfileByUsername(ctx, username)
 	if err != nil {
 		return nil, err
 	}
-	if profile == nil {
-		return nil, errors.New("profile not found")
+	if profile == nil || profile.Status == models.StatusErased {
+		return nil, ErrProfileNotFound
 	}
 
-	return &models.ProfileResponse{
-		UserProfile: *profile,
-	}, nil
+	return models.NewProfileResponse(profile, viewer.ViewOf(profile)), nil
 }
 
-func (s *UserService) UpdateProfile(ctx context.Context, userID primitive.ObjectID, req *models.UpdateProfileRequest) error {
-	// 프로필 존재 확인
-	profile, err := s.repo.GetProfileByID(ctx, userID)
+// GetProfileByAuthID 로그인 사용자의 프로필 조회 (/users/me)
+func (s *UserService) GetProfileByAuthID(ctx context.Context, authID primitive.ObjectID, viewer models.Viewer) (*models.ProfileResponse, error) {
+	profile, err := s.getProfileByAuthID(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewProfileResponse(profile, viewer.ViewOf(profile)), nil
+}
+
+// UpdateProfile 프로필 업데이트 (소유자만 가능, pre가 현재 버전과 맞지 않으면 ErrPreconditionFailed)
+func (s *UserService) UpdateProfile(ctx context.Context, userID primitive.ObjectID, viewer models.Viewer, req *models.UpdateProfileRequest, pre models.Precondition) error {
+	profile, err := s.getProfile(ctx, userID)
 	if err != nil {
 		return err
 	}
-	if profile == nil {
-		return errors.New("profile not found")
+	if viewer.ViewOf(profile) != models.ViewOwner {
+		return ErrNotProfileOwner
+	}
+
+	return s.updateProfile(ctx, profile, req, pre)
+}
+
+// UpdateProfileByAuthID 로그인 사용자의 프로필 업데이트 (/users/me)
+func (s *UserService) UpdateProfileByAuthID(ctx context.Context, authID primitive.ObjectID, req *models.UpdateProfileRequest, pre models.Precondition) error {
+	profile, err := s.getProfileByAuthID(ctx, authID)
+	if err != nil {
+		return err
 	}
 
-	// 업데이트할 필드 수집
+	return s.updateProfile(ctx, profile, req, pre)
+}
+
+func (s *UserService) updateProfile(ctx context.Context, profile *models.UserProfile, req *models.UpdateProfileRequest, pre models.Precondition) error {
+	userID := profile.ID
+	if !pre.Matches(profile.Version) {
+		return ErrPreconditionFailed
+	}
+	if !isEditable(profile.Status) {
+		return fmt.Errorf("%w while %s", ErrProfileNotEditable, profile.Status)
+	}
+
+	// 업데이트할 필드 수집 (검증 실패는 모두 모아서 반환)
 	update := bson.M{}
+	var violations fieldErrors
 
 	if req.Username != nil {
-		if err := validateUsername(*req.Username); err != nil {
-			return err
-		}
-		// username 중복 체크
-		existingProfile, err := s.repo.GetProfileByUsername(ctx, *req.Username)
-		if err != nil {
-			return err
-		}
-		if existingProfile != nil && existingProfile.ID != userID {
-			return errors.New("username already exists")
-		}
-		update["username"] = *req.Username
+		update["username"] = violations.apply("username", s.rules.Username, *req.Username)
 	}
-
 	if req.FirstName != nil {
-		if err := validateName(*req.FirstName); err != nil {
-			return err
-		}
-		update["first_name"] = *req.FirstName
+		update["first_name"] = violations.apply("first_name", s.rules.Name, *req.FirstName)
 	}
-
 	if req.LastName != nil {
-		if err := validateName(*req.LastName); err != nil {
-			return err
-		}
-		update["last_name"] = *req.LastName
+		update["last_name"] = violations.apply("last_name", s.rules.Name, *req.LastName)
 	}
-
+	country := profile.Address.Country
+	if req.Address != nil {
+		validateAddress(&violations, req.Address)
+		update["address"] = req.Address
+		country = req.Address.Country
+	}
+	var number *phone.Number
 	if req.PhoneNumber != nil {
-		if err := validatePhoneNumber(*req.PhoneNumber); err != nil {
+		number = s.normalizePhone(&violations, *req.PhoneNumber, country)
+	}
+	if err := violations.err(); err != nil {
+		return err
+	}
+	s.relocateAddress(ctx, req.Address, profile.Address) // 주소를 바꾸면서 좌표를 새로 지정하지 않으면 새 주소로 다시 지오코딩
+
+	// username 중복 체크
+	if username, ok := update["username"].(string); ok {
+		if err := s.checkUsernameAvailable(ctx, username, userID); err != nil {
 			return err
 		}
-		update["phone_number"] = *req.PhoneNumber
 	}
-
-	if req.Address != nil {
-		if err := validateAddress(req.Address); err != nil {
+	if number != nil {
+		if err := s.checkPhoneAvailable(ctx, number.E164, userID); err != nil {
 			return err
 		}
-		update["address"] = req.Address
+		update["phone_number"] = number.String()
+		update["phone_e164"] = number.E164
 	}
-
-	if len(update) == 0 {
-		return nil // 업데이트할 내용이 없음
+	var unset []string
+	if number != nil && number.E164 != profile.PhoneE164 {
+		unset = append(unset, "phone_verified_at") // 바뀐 번호는 다시 인증
 	}
 
-	return s.repo.UpdateProfile(ctx, userID, update)
-}
-
-func (s *UserService) DeleteProfile(ctx context.Context, userID primitive.ObjectID) error {
-	return s.repo.DeleteProfile(ctx, userID)
-}
-
-func (s *UserService) SearchProfiles(ctx context.Context, query string) ([]*models.ProfileResponse, error) {
-	if len(strings.TrimSpace(query)) < 2 {
-		return nil, errors.New("search query must be at least 2 characters")
+	if req.Privacy != nil {
+		update["privacy"] = req.Privacy
 	}
 
-	profiles, err := s.repo.SearchProfiles(ctx, query, 20) // 최대 20개 결과
-	if err != nil {
-		return nil, err
+	if len(update) == 0 {
+		return nil // 업데이트할 내용이 없음
 	}
 
-	responses := make([]*models.ProfileResponse, len(profiles))
-	for i, profile := range profiles {
-		responses[i] = &models.ProfileResponse{
-			UserProfile: *profile,
+	changes := repository.ProfileUpdate{Set: update, Unset: unset}
+	if req.Address != nil {
+		if err := routeLegacyAddress(profile, &changes, req.Address); err != nil {
+			return err
 		}
 	}
-
-	return responses, nil
+	return s.applyUpdate(ctx, profile, changes, pre)
 }
 
-// Validation helpers
-func validateCreateRequest(req *models.CreateProfileRequest) error {
-	if err := validateUsername(req.Username); err != nil {
+// DeleteProfile 프로필 삭제 (소유자 또는 관리자만 가능, soft delete)
+func (s *UserService) DeleteProfile(ctx context.Context, userID primitive.ObjectID, viewer models.Viewer, pre models.Precondition) error {
+	profile, err := s.getProfile(ctx, userID)
+	if err != nil {
 		return err
 	}
-	if err := validateName(req.FirstName); err != nil {
-		return err
+	if !pre.Matches(profile.Version) {
+		return ErrPreconditionFailed
 	}
-	if err := validateName(req.LastName); err != nil {
-		return err
+
+	switch viewer.ViewOf(profile) {
+	case models.ViewOwner:
+		return preconditionError(s.transition(ctx, profile, models.StatusInactive, ownerActor(viewer), "deleted by owner", nil), pre)
+	case models.ViewAdmin:
+		return preconditionError(s.transition(ctx, profile, models.StatusInactive, adminActor(viewer), "deleted by admin", nil), pre)
+	default:
+		return ErrNotProfileOwner
 	}
-	if err := validatePhoneNumber(req.PhoneNumber); err != nil {
+}
+
+// DeleteProfileByAuthID 로그인 사용자의 프로필 삭제 (/users/me)
+func (s *UserService) DeleteProfileByAuthID(ctx context.Context, authID primitive.ObjectID, pre models.Precondition) error {
+	profile, err := s.getProfileByAuthID(ctx, authID)
+	if err != nil {
 		return err
 	}
-	if err := validateAddress(&req.Address); err != nil {
-		return err
+	if !pre.Matches(profile.Version) {
+		return ErrPreconditionFailed
 	}
-	return nil
+
+	actor := models.Actor{Type: models.ActorOwner, ID: authID.Hex()}
+	return preconditionError(s.transition(ctx, profile, models.StatusInactive, actor, "deleted by owner", nil), pre)
 }
 
-func validateUsername(username string) error {
-	username = strings.TrimSpace(username)
-	if len(username) < 3 || len(username) > 30 {
-		return errors.New("username must be between 3 and 30 characters")
-	}
-	if match, _ := regexp.MatchString("^[a-zA-Z0-9_-]+$", username); !match {
-		return errors.New("username can only contain letters, numbers, underscores, and hyphens")
+// applyUpdate 조회 시점의 버전을 조건으로 업데이트 적용
+// 그 사이 다른 요청이 프로필을 바꿨으면 If-Match 요청은 ErrPreconditionFailed, 아니면 ErrVersionConflict
+// 이름, username, 이름 공개 설정이 바뀌면 자동완성 검색 키와 username 키도 함께 갱신
+func (s *UserService) applyUpdate(ctx context.Context, profile *models.UserProfile, update repository.ProfileUpdate, pre models.Precondition) error {
+	update = withUsernameKey(withSearchKeys(profile, update))
+	err := preconditionError(s.repo.UpdateProfile(ctx, profile.ID, profile.Version, update), pre)
+	if err != nil {
+		return err
 	}
+	s.reindexProfile(ctx, profile.ID)
 	return nil
 }
 
-func validateName(name string) error {
-	name = strings.TrimSpace(name)
-	if len(name) < 1 || len(name) > 50 {
-		return errors.New("name must be between 1 and 50 characters")
-	}
-	if match, _ := regexp.MatchString("^[a-zA-Z\\s-]+$", name); !match {
-		return errors.New("name can only contain letters, spaces, and hyphens")
+// withUsernameKey username이 바뀌면 중복 확인용 정규화 키를 업데이트에 추가
+func withUsernameKey(update repository.ProfileUpdate) repository.ProfileUpdate {
+	if username, ok := update.Set["username"].(string); ok {
+		update.Set["username_key"] = utils.UsernameKey(username)
 	}
-	return nil
+	return update
 }
 
-func validatePhoneNumber(phone string) error {
-	phone = strings.TrimSpace(phone)
-	if match, _ := regexp.MatchString("^\\+?[0-9]{10,15}$", phone); !match {
-		return errors.New("invalid phone number format")
+// preconditionError If-Match 요청이 조회 이후 바뀐 프로필과 충돌하면 ErrPreconditionFailed로 변환
+func preconditionError(err error, pre models.Precondition) error {
+	if pre.Present && (errors.Is(err, ErrVersionConflict) || errors.Is(err, repository.ErrStatusConflict)) {
+		return ErrPreconditionFailed
 	}
-	return nil
+	return err
 }
 
-func validateAddress(addr *models.Address) error {
-	if addr == nil {
-		return errors.New("address is required")
+// getProfile ID로 프로필 조회 (없으면 ErrProfileNotFound)
+func (s *UserService) getProfile(ctx context.Context, userID primitive.ObjectID) (*models.UserProfile, error) {
+	profile, err := s.repo.GetProfileByID(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
-	if strings.TrimSpace(addr.Street) == "" {
-		return errors.New("street is required")
+	if profile == nil {
+		return nil, ErrProfileNotFound
 	}
-	if strings.TrimSpace(addr.City) == "" {
-		return errors.New("city is required")
+	if err := s.expireSuspension(ctx, profile); err != nil {
+		return nil, err
 	}
-	if strings.TrimSpace(addr.Country) == "" {
-		return errors.New("country is required")
+	return profile, nil
+}
+
+// getProfileByAuthID Auth ID로 프로필 조회 (없으면 ErrProfileNotCreated)
+func (s *UserService) getProfileByAuthID(ctx context.Context, authID primitive.ObjectID) (*models.UserProfile, error) {
+	profile, err := s.repo.GetProfileByAuthID(ctx, authID)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	if profile == nil {
+		return nil, ErrProfileNotCreated
+	}
+	if err := s.expireSuspension(ctx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func ownerActor(viewer models.Viewer) models.Actor {
+	return models.Actor{Type: models.ActorOwner, ID: viewer.AuthID}
+}
+
+func adminActor(viewer models.Viewer) models.Actor {
+	return models.Actor{Type: models.ActorAdmin, ID: viewer.AuthID}
+}
+
+// Validation helpers
+
+// validateCreateRequest 검증 규칙 적용 후 요청 값을 정규화된 값으로 교체
+// 전화번호는 주소 국가를 기본 지역으로 정규화한 번호 반환
+func (s *UserService) validateCreateRequest(req *models.CreateProfileRequest) (*phone.Number, error) {
+	var violations fieldErrors
+	req.Username = violations.apply("username", s.rules.Username, req.Username)
+	req.FirstName = violations.apply("first_name", s.rules.Name, req.FirstName)
+	req.LastName = violations.apply("last_name", s.rules.Name, req.LastName)
+	validateAddress(&violations, &req.Address)
+	number := s.normalizePhone(&violations, req.PhoneNumber, req.Address.Country)
+	return number, violations.err()
+}
+
+// validateAddress 국가별 주소 규칙 검사 후 정규화된 값으로 교체 (위반 사항은 address.* 경로로 기록)
+func validateAddress(violations *fieldErrors, addr *models.Address) {
+	if addr == nil {
+		violations.add("address", utils.ViolationRequired, nil, "address is required")
+		return
+	}
+	fields, invalid := address.Normalize(address.Fields{
+		Street:     addr.Street,
+		City:       addr.City,
+		State:      addr.State,
+		PostalCode: addr.PostalCode,
+		Country:    addr.Country,
+	})
+	for _, v := range invalid {
+		*violations = append(*violations, FieldError{Field: "address." + v.Field, Code: v.Code, Message: v.Message, Params: v.Params})
+	}
+	addr.Street = fields.Street
+	addr.City = fields.City
+	addr.State = fields.State
+	addr.PostalCode = fields.PostalCode
+	addr.Country = fields.Country
+	validateLocation(violations, addr.Location)
 }
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// FieldError 필드 단위 검증 실패
+// Field는 요청 본문 기준 경로(address.city 형식) 또는 쿼리 파라미터 이름
+type FieldError struct {
+	Field   string            `json:"field"`
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Params  map[string]string `json:"params,omitempty"` // 메시지에 들어가는 값 (클라이언트 번역용)
+}
+
+// ValidationError 요청 검증 실패 (첫 번째 위반만이 아니라 모든 위반 사항 포함)
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Field + ": " + field.Message
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+// fieldErrors 검증 중 발견한 위반 사항 수집
+type fieldErrors []FieldError
+
+func (v *fieldErrors) add(field, code string, params map[string]string, format string, args ...interface{}) {
+	*v = append(*v, FieldError{Field: field, Code: code, Message: fmt.Sprintf(format, args...), Params: params})
+}
+
+// apply 검증 규칙을 적용해 정규화된 값 반환 (위반 시 기록하고 원래 값 반환)
+func (v *fieldErrors) apply(field string, rule utils.TextRule, value string) string {
+	normalized, err := rule.Apply(value)
+	if err != nil {
+		v.addError(field, err)
+		return value
+	}
+	return normalized
+}
+
+// addError 규칙 위반 에러 기록 (RuleViolation이 아니면 invalid_value 코드 사용)
+func (v *fieldErrors) addError(field string, err error) {
+	var violation *utils.RuleViolation
+	if errors.As(err, &violation) {
+		*v = append(*v, FieldError{Field: field, Code: violation.Code, Message: violation.Message, Params: violation.Params})
+		return
+	}
+	*v = append(*v, FieldError{Field: field, Code: utils.ViolationInvalidValue, Message: err.Error()})
+}
+
+func (v fieldErrors) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v}
+}
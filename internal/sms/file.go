@@ -0,0 +1,58 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileSender 문자를 JSON Lines 형식으로 파일에 추가하는 발송기 (로컬 개발, 통합 테스트용)
+type FileSender struct {
+	mu   sync.Mutex
+	path string
+}
+
+// outboxMessage 발송 파일의 한 줄
+type outboxMessage struct {
+	To      string    `json:"to"`
+	Message string    `json:"message"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// NewFileSender path에 문자를 기록하는 발송기 생성 (파일이 없으면 만들고, 쓸 수 없으면 에러)
+func NewFileSender(path string) (*FileSender, error) {
+	if path == "" {
+		return nil, fmt.Errorf("SMS outbox path is required")
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return &FileSender{path: path}, nil
+}
+
+func (s *FileSender) Send(ctx context.Context, to, message string) error {
+	line, err := json.Marshal(outboxMessage{To: to, Message: message, SentAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
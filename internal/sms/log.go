@@ -0,0 +1,19 @@
+package sms
+
+import (
+	"context"
+	"log"
+)
+
+// LogSender 문자를 보내지 않고 서버 로그에 출력하는 발송기 (로컬 개발용, 인증번호가 로그에 남음)
+type LogSender struct{}
+
+func NewLogSender() *LogSender {
+	log.Printf("Warning: SMS messages are written to the server log (development only)")
+	return &LogSender{}
+}
+
+func (s *LogSender) Send(ctx context.Context, to, message string) error {
+	log.Printf("SMS to %s: %s", to, message)
+	return nil
+}
@@ -0,0 +1,61 @@
+package address
+
+import (
+	"strings"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// isoCodes ISO 3166-1 alpha-2 국가 코드 전체
+var isoCodes = strings.Fields(`
+AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ
+CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO
+FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE
+JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO
+MP MQ MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW
+PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM
+TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW`)
+
+// countryNames 국가 이름으로 입력할 수 있는 국가 (첫 이름이 영문 표기, 국제 우편 주소의 국가 줄에 사용)
+var countryNames = map[string][]string{
+	"KR": {"Republic of Korea", "Korea", "South Korea", "대한민국", "한국"},
+	"US": {"United States", "USA", "United States of America", "미국"},
+	"JP": {"Japan", "日本", "일본"},
+	"CN": {"China", "中国", "중국"},
+	"GB": {"United Kingdom", "UK", "Great Britain", "England", "영국"},
+	"DE": {"Germany", "Deutschland", "독일"},
+	"FR": {"France", "프랑스"},
+	"CA": {"Canada", "캐나다"},
+	"AU": {"Australia", "호주"},
+	"SG": {"Singapore", "싱가포르"},
+}
+
+var countryIndex = buildCountryIndex()
+
+func buildCountryIndex() map[string]string {
+	index := make(map[string]string, len(isoCodes))
+	for _, code := range isoCodes {
+		index[utils.FoldSearchKey(code)] = code
+	}
+	for code, names := range countryNames {
+		for _, name := range names {
+			index[utils.FoldSearchKey(name)] = code
+		}
+	}
+	return index
+}
+
+// ResolveCountry ISO 3166-1 alpha-2 코드 또는 알려진 국가 이름을 코드로 변환
+// 대소문자와 발음 구별 기호는 무시하며, 알 수 없으면 false
+func ResolveCountry(country string) (string, bool) {
+	code, ok := countryIndex[utils.FoldSearchKey(country)]
+	return code, ok
+}
+
+// CountryName 국제 우편 주소에 쓰는 영문 국가 이름 (이름을 모르면 코드)
+func CountryName(code string) string {
+	if names, ok := countryNames[code]; ok {
+		return names[0]
+	}
+	return code
+}
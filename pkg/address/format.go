@@ -0,0 +1,45 @@
+package address
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	repeatedCommas = regexp.MustCompile(`\s*,(\s*,)*\s*`)
+	repeatedSpaces = regexp.MustCompile(`\s+`)
+)
+
+// Format 받는 국가의 관례에 맞는 줄 단위 주소
+// 템플릿의 %A는 도로명, %C는 도시, %S는 행정 구역, %Z는 우편번호, %n은 줄바꿈이며 빈 줄은 생략
+// origin(보내는 국가)이 받는 국가와 다르거나 비어 있으면 마지막 줄에 영문 국가 이름을 대문자로 추가
+func Format(f Fields, origin string) []string {
+	code, ok := ResolveCountry(f.Country)
+	if !ok {
+		code = strings.ToUpper(strings.TrimSpace(f.Country))
+	}
+	rules := RulesFor(code)
+
+	replacer := strings.NewReplacer(
+		"%A", f.Street,
+		"%C", f.City,
+		"%S", f.State,
+		"%Z", f.PostalCode,
+	)
+
+	var lines []string
+	for _, line := range strings.Split(rules.Layout, "%n") {
+		line = replacer.Replace(line)
+		line = repeatedCommas.ReplaceAllString(line, ", ")
+		line = repeatedSpaces.ReplaceAllString(line, " ")
+		line = strings.Trim(line, " ,")
+		if line != "" && line != "〒" {
+			lines = append(lines, line)
+		}
+	}
+
+	if originCode, _ := ResolveCountry(origin); code != "" && originCode != code {
+		lines = append(lines, strings.ToUpper(CountryName(code)))
+	}
+	return lines
+}
@@ -0,0 +1,268 @@
+package address
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+// 주소 필드 이름 (JSON, BSON 필드명과 같음)
+const (
+	FieldStreet     = "street"
+	FieldCity       = "city"
+	FieldState      = "state"
+	FieldPostalCode = "postal_code"
+	FieldCountry    = "country"
+)
+
+// Fields 검증, 서식 지정 대상 주소 (country는 ISO 코드 또는 국가 이름)
+type Fields struct {
+	Street     string
+	City       string
+	State      string
+	PostalCode string
+	Country    string
+}
+
+// Violation 주소 규칙 위반 (Code는 utils.Violation* 코드)
+type Violation struct {
+	Field   string
+	Code    string
+	Message string
+	Params  map[string]string
+}
+
+// State 시/도, 주(state), 현(prefecture) 등 1단계 행정 구역
+type State struct {
+	Code  string
+	Names []string // 입력으로 받는 이름 (첫 이름이 영문 표기)
+}
+
+// Rules 국가별 주소 규칙
+type Rules struct {
+	Required      []string       // 필수 필드 (country는 항상 필수)
+	PostalPattern *regexp.Regexp // 정규화된 우편번호 형식 (nil이면 검사하지 않음)
+	PostalExample string         // 에러 메시지에 보여줄 우편번호 예시
+	PostalFormat  func(string) string
+	States        []State // 비어 있으면 행정 구역을 검사하지 않음
+	StateAsCode   bool    // 행정 구역을 코드로 저장 (예: US의 CA, NY)
+	Layout        string  // 서식 템플릿 (Format 참고)
+}
+
+// defaultRules 규칙표에 없는 국가의 기본 규칙
+var defaultRules = &Rules{
+	Required: []string{FieldStreet, FieldCity},
+	Layout:   "%A%n%C %S %Z",
+}
+
+// countryRules 국가별 주소 규칙표
+var countryRules = map[string]*Rules{
+	"KR": {
+		Required:      []string{FieldStreet, FieldCity},
+		PostalPattern: regexp.MustCompile(`^\d{5}$`),
+		PostalExample: "06236",
+		States:        krStates,
+		Layout:        "%S %C %A%n%Z",
+	},
+	"US": {
+		Required:      []string{FieldStreet, FieldCity, FieldState, FieldPostalCode},
+		PostalPattern: regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+		PostalExample: "94103",
+		States:        usStates,
+		StateAsCode:   true,
+		Layout:        "%A%n%C, %S %Z",
+	},
+	"JP": {
+		Required:      []string{FieldStreet, FieldCity, FieldState, FieldPostalCode},
+		PostalPattern: regexp.MustCompile(`^\d{3}-\d{4}$`),
+		PostalExample: "100-0001",
+		PostalFormat:  hyphenate(3),
+		States:        jpPrefectures,
+		Layout:        "〒%Z%n%S %C %A",
+	},
+	"CN": {
+		Required:      []string{FieldStreet, FieldCity, FieldState},
+		PostalPattern: regexp.MustCompile(`^\d{6}$`),
+		PostalExample: "100000",
+		Layout:        "%S %C %A%n%Z",
+	},
+	"GB": {
+		Required:      []string{FieldStreet, FieldCity, FieldPostalCode},
+		PostalPattern: regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? \d[A-Z]{2}$`),
+		PostalExample: "SW1A 1AA",
+		PostalFormat:  spaceBeforeLast(3),
+		Layout:        "%A%n%C%n%Z",
+	},
+	"DE": {
+		Required:      []string{FieldStreet, FieldCity, FieldPostalCode},
+		PostalPattern: regexp.MustCompile(`^\d{5}$`),
+		PostalExample: "10115",
+		Layout:        "%A%n%Z %C",
+	},
+	"FR": {
+		Required:      []string{FieldStreet, FieldCity, FieldPostalCode},
+		PostalPattern: regexp.MustCompile(`^\d{5}$`),
+		PostalExample: "75008",
+		Layout:        "%A%n%Z %C",
+	},
+	"CA": {
+		Required:      []string{FieldStreet, FieldCity, FieldState, FieldPostalCode},
+		PostalPattern: regexp.MustCompile(`^[A-Z]\d[A-Z] \d[A-Z]\d$`),
+		PostalExample: "K1A 0B1",
+		PostalFormat:  spaceBeforeLast(3),
+		States:        caProvinces,
+		StateAsCode:   true,
+		Layout:        "%A%n%C %S %Z",
+	},
+	"AU": {
+		Required:      []string{FieldStreet, FieldCity, FieldState, FieldPostalCode},
+		PostalPattern: regexp.MustCompile(`^\d{4}$`),
+		PostalExample: "2000",
+		States:        auStates,
+		StateAsCode:   true,
+		Layout:        "%A%n%C %S %Z",
+	},
+	"SG": {
+		Required:      []string{FieldStreet, FieldPostalCode},
+		PostalPattern: regexp.MustCompile(`^\d{6}$`),
+		PostalExample: "018956",
+		Layout:        "%A%nSINGAPORE %Z",
+	},
+}
+
+// RulesFor 국가 코드의 주소 규칙 (규칙표에 없으면 기본 규칙)
+func RulesFor(code string) *Rules {
+	if rules, ok := countryRules[code]; ok {
+		return rules
+	}
+	return defaultRules
+}
+
+// Normalize 국가 규칙에 따라 주소를 검사하고 정규화된 주소 반환
+// 국가는 ISO 코드로, 우편번호와 코드로 저장하는 행정 구역은 표준 표기로 바꿈
+func Normalize(f Fields) (Fields, []Violation) {
+	f = Fields{
+		Street:     collapse(f.Street),
+		City:       collapse(f.City),
+		State:      collapse(f.State),
+		PostalCode: collapse(f.PostalCode),
+		Country:    collapse(f.Country),
+	}
+
+	var violations []Violation
+	add := func(field, code string, params map[string]string, format string, args ...interface{}) {
+		violations = append(violations, Violation{Field: field, Code: code, Message: fmt.Sprintf(format, args...), Params: params})
+	}
+
+	if f.Country == "" {
+		add(FieldCountry, utils.ViolationRequired, nil, "country is required")
+		for _, field := range defaultRules.Required {
+			if f.value(field) == "" {
+				add(field, utils.ViolationRequired, nil, "%s is required", label(field))
+			}
+		}
+		return f, violations
+	}
+	code, ok := ResolveCountry(f.Country)
+	if !ok {
+		add(FieldCountry, utils.ViolationInvalidValue, map[string]string{"value": f.Country},
+			"unknown country %q: use an ISO 3166-1 alpha-2 code", f.Country)
+		return f, violations
+	}
+	f.Country = code
+	rules := RulesFor(code)
+
+	for _, field := range rules.Required {
+		if f.value(field) == "" {
+			add(field, utils.ViolationRequired, nil, "%s is required in %s", label(field), code)
+		}
+	}
+
+	if f.PostalCode != "" && rules.PostalPattern != nil {
+		postal := strings.ToUpper(f.PostalCode)
+		if rules.PostalFormat != nil {
+			postal = rules.PostalFormat(postal)
+		}
+		if rules.PostalPattern.MatchString(postal) {
+			f.PostalCode = postal
+		} else {
+			add(FieldPostalCode, utils.ViolationInvalidFormat, map[string]string{"example": rules.PostalExample, "country": code},
+				"invalid postal code for %s (example: %s)", code, rules.PostalExample)
+		}
+	}
+
+	if f.State != "" && len(rules.States) > 0 {
+		state, ok := rules.findState(f.State)
+		switch {
+		case !ok:
+			add(FieldState, utils.ViolationInvalidValue, map[string]string{"value": f.State, "country": code},
+				"unknown state or province %q for %s", f.State, code)
+		case rules.StateAsCode:
+			f.State = state.Code
+		}
+	}
+
+	return f, violations
+}
+
+func (r *Rules) findState(value string) (State, bool) {
+	key := utils.FoldSearchKey(value)
+	for _, state := range r.States {
+		if utils.FoldSearchKey(state.Code) == key {
+			return state, true
+		}
+		for _, name := range state.Names {
+			if utils.FoldSearchKey(name) == key {
+				return state, true
+			}
+		}
+	}
+	return State{}, false
+}
+
+func (f Fields) value(field string) string {
+	switch field {
+	case FieldStreet:
+		return f.Street
+	case FieldCity:
+		return f.City
+	case FieldState:
+		return f.State
+	case FieldPostalCode:
+		return f.PostalCode
+	default:
+		return f.Country
+	}
+}
+
+func label(field string) string {
+	return strings.ReplaceAll(field, "_", " ")
+}
+
+// collapse 앞뒤 공백 제거 후 연속 공백을 하나로 합침
+func collapse(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// hyphenate 숫자만 입력된 우편번호의 n번째 자리 뒤에 하이픈 추가 (1000001 → 100-0001)
+func hyphenate(n int) func(string) string {
+	return func(postal string) string {
+		if len(postal) > n && !strings.Contains(postal, "-") {
+			return postal[:n] + "-" + postal[n:]
+		}
+		return postal
+	}
+}
+
+// spaceBeforeLast 공백 없이 입력된 우편번호의 마지막 n자리 앞에 공백 추가 (SW1A1AA → SW1A 1AA)
+func spaceBeforeLast(n int) func(string) string {
+	return func(postal string) string {
+		postal = strings.ReplaceAll(postal, " ", "")
+		if len(postal) > n {
+			return postal[:len(postal)-n] + " " + postal[len(postal)-n:]
+		}
+		return postal
+	}
+}
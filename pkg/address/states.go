@@ -0,0 +1,81 @@
+package address
+
+// krStates 대한민국 광역자치단체 (ISO 3166-2:KR)
+var krStates = []State{
+	{"11", []string{"Seoul", "서울특별시", "서울"}},
+	{"26", []string{"Busan", "부산광역시", "부산"}},
+	{"27", []string{"Daegu", "대구광역시", "대구"}},
+	{"28", []string{"Incheon", "인천광역시", "인천"}},
+	{"29", []string{"Gwangju", "광주광역시", "광주"}},
+	{"30", []string{"Daejeon", "대전광역시", "대전"}},
+	{"31", []string{"Ulsan", "울산광역시", "울산"}},
+	{"50", []string{"Sejong", "세종특별자치시", "세종"}},
+	{"41", []string{"Gyeonggi-do", "Gyeonggi", "경기도", "경기"}},
+	{"51", []string{"Gangwon-do", "Gangwon", "강원특별자치도", "강원도", "강원"}},
+	{"43", []string{"Chungcheongbuk-do", "North Chungcheong", "충청북도", "충북"}},
+	{"44", []string{"Chungcheongnam-do", "South Chungcheong", "충청남도", "충남"}},
+	{"52", []string{"Jeonbuk-do", "North Jeolla", "Jeollabuk-do", "전북특별자치도", "전라북도", "전북"}},
+	{"46", []string{"Jeollanam-do", "South Jeolla", "전라남도", "전남"}},
+	{"47", []string{"Gyeongsangbuk-do", "North Gyeongsang", "경상북도", "경북"}},
+	{"48", []string{"Gyeongsangnam-do", "South Gyeongsang", "경상남도", "경남"}},
+	{"49", []string{"Jeju-do", "Jeju", "제주특별자치도", "제주도", "제주"}},
+}
+
+// usStates 미국 주, 컬럼비아 특별구, 자치령 (USPS 약어)
+var usStates = []State{
+	{"AL", []string{"Alabama"}}, {"AK", []string{"Alaska"}}, {"AZ", []string{"Arizona"}},
+	{"AR", []string{"Arkansas"}}, {"CA", []string{"California"}}, {"CO", []string{"Colorado"}},
+	{"CT", []string{"Connecticut"}}, {"DE", []string{"Delaware"}}, {"DC", []string{"District of Columbia", "Washington DC"}},
+	{"FL", []string{"Florida"}}, {"GA", []string{"Georgia"}}, {"HI", []string{"Hawaii"}},
+	{"ID", []string{"Idaho"}}, {"IL", []string{"Illinois"}}, {"IN", []string{"Indiana"}},
+	{"IA", []string{"Iowa"}}, {"KS", []string{"Kansas"}}, {"KY", []string{"Kentucky"}},
+	{"LA", []string{"Louisiana"}}, {"ME", []string{"Maine"}}, {"MD", []string{"Maryland"}},
+	{"MA", []string{"Massachusetts"}}, {"MI", []string{"Michigan"}}, {"MN", []string{"Minnesota"}},
+	{"MS", []string{"Mississippi"}}, {"MO", []string{"Missouri"}}, {"MT", []string{"Montana"}},
+	{"NE", []string{"Nebraska"}}, {"NV", []string{"Nevada"}}, {"NH", []string{"New Hampshire"}},
+	{"NJ", []string{"New Jersey"}}, {"NM", []string{"New Mexico"}}, {"NY", []string{"New York"}},
+	{"NC", []string{"North Carolina"}}, {"ND", []string{"North Dakota"}}, {"OH", []string{"Ohio"}},
+	{"OK", []string{"Oklahoma"}}, {"OR", []string{"Oregon"}}, {"PA", []string{"Pennsylvania"}},
+	{"RI", []string{"Rhode Island"}}, {"SC", []string{"South Carolina"}}, {"SD", []string{"South Dakota"}},
+	{"TN", []string{"Tennessee"}}, {"TX", []string{"Texas"}}, {"UT", []string{"Utah"}},
+	{"VT", []string{"Vermont"}}, {"VA", []string{"Virginia"}}, {"WA", []string{"Washington"}},
+	{"WV", []string{"West Virginia"}}, {"WI", []string{"Wisconsin"}}, {"WY", []string{"Wyoming"}},
+	{"PR", []string{"Puerto Rico"}}, {"GU", []string{"Guam"}}, {"VI", []string{"U.S. Virgin Islands", "Virgin Islands"}},
+	{"AS", []string{"American Samoa"}}, {"MP", []string{"Northern Mariana Islands"}},
+}
+
+// jpPrefectures 일본 도도부현 (ISO 3166-2:JP)
+var jpPrefectures = []State{
+	{"01", []string{"Hokkaido", "北海道"}}, {"02", []string{"Aomori", "青森県"}}, {"03", []string{"Iwate", "岩手県"}},
+	{"04", []string{"Miyagi", "宮城県"}}, {"05", []string{"Akita", "秋田県"}}, {"06", []string{"Yamagata", "山形県"}},
+	{"07", []string{"Fukushima", "福島県"}}, {"08", []string{"Ibaraki", "茨城県"}}, {"09", []string{"Tochigi", "栃木県"}},
+	{"10", []string{"Gunma", "群馬県"}}, {"11", []string{"Saitama", "埼玉県"}}, {"12", []string{"Chiba", "千葉県"}},
+	{"13", []string{"Tokyo", "東京都"}}, {"14", []string{"Kanagawa", "神奈川県"}}, {"15", []string{"Niigata", "新潟県"}},
+	{"16", []string{"Toyama", "富山県"}}, {"17", []string{"Ishikawa", "石川県"}}, {"18", []string{"Fukui", "福井県"}},
+	{"19", []string{"Yamanashi", "山梨県"}}, {"20", []string{"Nagano", "長野県"}}, {"21", []string{"Gifu", "岐阜県"}},
+	{"22", []string{"Shizuoka", "静岡県"}}, {"23", []string{"Aichi", "愛知県"}}, {"24", []string{"Mie", "三重県"}},
+	{"25", []string{"Shiga", "滋賀県"}}, {"26", []string{"Kyoto", "京都府"}}, {"27", []string{"Osaka", "大阪府"}},
+	{"28", []string{"Hyogo", "兵庫県"}}, {"29", []string{"Nara", "奈良県"}}, {"30", []string{"Wakayama", "和歌山県"}},
+	{"31", []string{"Tottori", "鳥取県"}}, {"32", []string{"Shimane", "島根県"}}, {"33", []string{"Okayama", "岡山県"}},
+	{"34", []string{"Hiroshima", "広島県"}}, {"35", []string{"Yamaguchi", "山口県"}}, {"36", []string{"Tokushima", "徳島県"}},
+	{"37", []string{"Kagawa", "香川県"}}, {"38", []string{"Ehime", "愛媛県"}}, {"39", []string{"Kochi", "高知県"}},
+	{"40", []string{"Fukuoka", "福岡県"}}, {"41", []string{"Saga", "佐賀県"}}, {"42", []string{"Nagasaki", "長崎県"}},
+	{"43", []string{"Kumamoto", "熊本県"}}, {"44", []string{"Oita", "大分県"}}, {"45", []string{"Miyazaki", "宮崎県"}},
+	{"46", []string{"Kagoshima", "鹿児島県"}}, {"47", []string{"Okinawa", "沖縄県"}},
+}
+
+// caProvinces 캐나다 주와 준주 (Canada Post 약어)
+var caProvinces = []State{
+	{"AB", []string{"Alberta"}}, {"BC", []string{"British Columbia"}}, {"MB", []string{"Manitoba"}},
+	{"NB", []string{"New Brunswick"}}, {"NL", []string{"Newfoundland and Labrador"}}, {"NS", []string{"Nova Scotia"}},
+	{"NT", []string{"Northwest Territories"}}, {"NU", []string{"Nunavut"}}, {"ON", []string{"Ontario"}},
+	{"PE", []string{"Prince Edward Island"}}, {"QC", []string{"Quebec", "Québec"}}, {"SK", []string{"Saskatchewan"}},
+	{"YT", []string{"Yukon"}},
+}
+
+// auStates 호주 주와 준주
+var auStates = []State{
+	{"ACT", []string{"Australian Capital Territory"}}, {"NSW", []string{"New South Wales"}},
+	{"NT", []string{"Northern Territory"}}, {"QLD", []string{"Queensland"}}, {"SA", []string{"South Australia"}},
+	{"TAS", []string{"Tasmania"}}, {"VIC", []string{"Victoria"}}, {"WA", []string{"Western Australia"}},
+}
@@ -0,0 +1,124 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+)
+
+// 지원하는 이미지 형식 (image.DecodeConfig가 돌려주는 이름)
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatGIF  = "gif"
+)
+
+const jpegQuality = 85
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrInvalidImage      = errors.New("invalid image data")
+	ErrTooLarge          = errors.New("image dimensions are too large")
+)
+
+// Decode 이미지를 디코딩해 가로, 세로가 모두 maxSide 이하가 되도록 축소하고 EXIF 방향을 적용한 RGBA 이미지로 반환
+// 픽셀 수가 maxPixels를 넘으면 디코딩 전에 ErrTooLarge 반환 (큰 이미지로 메모리를 소진시키는 요청 방지, 0이면 제한 없음)
+// 원본 크기의 RGBA 복사본은 하나만 만들고 방향은 축소한 뒤에 적용하며, 반환하는 Config는 원본의 크기
+// GIF는 첫 프레임만 사용하며, 결과 이미지에는 원본의 메타데이터가 남지 않음
+func Decode(data []byte, maxPixels, maxSide int) (*image.RGBA, image.Config, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if errors.Is(err, image.ErrFormat) {
+		return nil, config, ErrUnsupportedFormat
+	}
+	if err != nil || config.Width <= 0 || config.Height <= 0 {
+		return nil, config, ErrInvalidImage
+	}
+	if maxPixels > 0 && int64(config.Width)*int64(config.Height) > int64(maxPixels) {
+		return nil, config, ErrTooLarge
+	}
+
+	var img image.Image
+	switch format {
+	case FormatJPEG:
+		img, err = jpeg.Decode(bytes.NewReader(data))
+	case FormatPNG:
+		img, err = png.Decode(bytes.NewReader(data))
+	case FormatGIF:
+		img, err = gif.Decode(bytes.NewReader(data))
+	default:
+		return nil, config, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, config, ErrInvalidImage
+	}
+
+	rgba := Fit(toRGBA(img), maxSide)
+	if format == FormatJPEG {
+		rgba = orient(rgba, exifOrientation(data))
+	}
+	return rgba, config, nil
+}
+
+// Encode 투명 픽셀이 있으면 PNG, 없으면 JPEG로 인코딩
+// 반환값은 인코딩된 데이터와 Content-Type
+func Encode(img *image.RGBA) ([]byte, string, error) {
+	var buf bytes.Buffer
+	if !img.Opaque() {
+		encoder := png.Encoder{CompressionLevel: png.BestCompression}
+		if err := encoder.Encode(&buf, img); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "image/png", nil
+	}
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), "image/jpeg", nil
+}
+
+// Extension Content-Type에 맞는 파일 확장자
+func Extension(contentType string) string {
+	switch contentType {
+	case "image/png":
+		return ".png"
+	default:
+		return ".jpg"
+	}
+}
+
+// Fit 비율을 유지하며 가로, 세로가 모두 maxSide 이하가 되도록 축소 (이미 작거나 maxSide가 0이면 그대로 반환)
+func Fit(img *image.RGBA, maxSide int) *image.RGBA {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	if maxSide <= 0 || (w <= maxSide && h <= maxSide) {
+		return img
+	}
+	if w >= h {
+		return Resize(img, maxSide, max(1, h*maxSide/w))
+	}
+	return Resize(img, max(1, w*maxSide/h), maxSide)
+}
+
+// Thumbnail 가운데를 정사각형으로 잘라 size 크기로 축소 (짧은 변이 size보다 작으면 확대하지 않음)
+func Thumbnail(img *image.RGBA, size int) *image.RGBA {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x := b.Min.X + (b.Dx()-side)/2
+	y := b.Min.Y + (b.Dy()-side)/2
+	square := img.SubImage(image.Rect(x, y, x+side, y+side)).(*image.RGBA)
+	return Resize(square, min(size, side), min(size, side))
+}
+
+// toRGBA 임의의 이미지를 원점 기준 RGBA로 복사 (이미 원점 기준 RGBA면 그대로 사용)
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	return rgba
+}
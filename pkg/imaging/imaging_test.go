@@ -0,0 +1,133 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// encodeJPEG JPEG로 인코딩하고 SOI 바로 뒤에 segments를 끼워 넣음
+func encodeJPEG(t *testing.T, img image.Image, segments ...[]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	out := append([]byte{}, data[:2]...)
+	for _, seg := range segments {
+		out = append(out, seg...)
+	}
+	return append(out, data[2:]...)
+}
+
+func TestDecode(t *testing.T) {
+	wide := filled(40, 20, color.RGBA{R: 200, A: 255})
+
+	tests := []struct {
+		name       string
+		data       []byte
+		maxPixels  int
+		maxSide    int
+		wantBounds image.Rectangle
+		wantConfig image.Point
+		wantErr    error
+	}{
+		{name: "png fits", data: encodePNG(t, wide), maxSide: 100, wantBounds: image.Rect(0, 0, 40, 20), wantConfig: image.Pt(40, 20)},
+		{name: "png is shrunk", data: encodePNG(t, wide), maxSide: 10, wantBounds: image.Rect(0, 0, 10, 5), wantConfig: image.Pt(40, 20)},
+		{name: "jpeg without EXIF", data: encodeJPEG(t, wide), maxSide: 100, wantBounds: image.Rect(0, 0, 40, 20), wantConfig: image.Pt(40, 20)},
+		{name: "jpeg rotated by EXIF", data: encodeJPEG(t, wide, exifSegment(binary.BigEndian, 6)), maxSide: 100, wantBounds: image.Rect(0, 0, 20, 40), wantConfig: image.Pt(40, 20)},
+		{name: "jpeg rotated after shrinking", data: encodeJPEG(t, wide, exifSegment(binary.LittleEndian, 8)), maxSide: 10, wantBounds: image.Rect(0, 0, 5, 10), wantConfig: image.Pt(40, 20)},
+		{name: "too many pixels", data: encodePNG(t, wide), maxPixels: 799, maxSide: 100, wantErr: ErrTooLarge},
+		{name: "pixel limit is inclusive", data: encodePNG(t, wide), maxPixels: 800, maxSide: 100, wantBounds: image.Rect(0, 0, 40, 20), wantConfig: image.Pt(40, 20)},
+		{name: "unknown format", data: []byte("GIF87 is not quite right"), maxSide: 100, wantErr: ErrUnsupportedFormat},
+		{name: "truncated png", data: encodePNG(t, wide)[:40], maxSide: 100, wantErr: ErrInvalidImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img, config, err := Decode(tt.data, tt.maxPixels, tt.maxSide)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if img.Bounds() != tt.wantBounds {
+				t.Errorf("bounds = %v, want %v", img.Bounds(), tt.wantBounds)
+			}
+			if got := image.Pt(config.Width, config.Height); got != tt.wantConfig {
+				t.Errorf("config = %v, want %v", got, tt.wantConfig)
+			}
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name string
+		img  *image.RGBA
+		want string
+	}{
+		{name: "opaque", img: filled(4, 4, color.RGBA{G: 100, A: 255}), want: "image/jpeg"},
+		{name: "transparent", img: filled(4, 4, color.RGBA{G: 100, A: 100}), want: "image/png"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, contentType, err := Encode(tt.img)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if contentType != tt.want {
+				t.Errorf("content type = %s, want %s", contentType, tt.want)
+			}
+			if _, _, err := Decode(data, 0, 0); err != nil {
+				t.Errorf("encoded image does not decode: %v", err)
+			}
+		})
+	}
+}
+
+func TestFitAndThumbnail(t *testing.T) {
+	tests := []struct {
+		name string
+		w, h int
+		fn   func(*image.RGBA) *image.RGBA
+		want image.Point
+	}{
+		{name: "fit landscape", w: 400, h: 300, fn: func(img *image.RGBA) *image.RGBA { return Fit(img, 100) }, want: image.Pt(100, 75)},
+		{name: "fit portrait", w: 300, h: 400, fn: func(img *image.RGBA) *image.RGBA { return Fit(img, 100) }, want: image.Pt(75, 100)},
+		{name: "fit keeps small images", w: 50, h: 60, fn: func(img *image.RGBA) *image.RGBA { return Fit(img, 100) }, want: image.Pt(50, 60)},
+		{name: "fit without limit", w: 500, h: 60, fn: func(img *image.RGBA) *image.RGBA { return Fit(img, 0) }, want: image.Pt(500, 60)},
+		{name: "fit keeps at least one pixel", w: 1000, h: 2, fn: func(img *image.RGBA) *image.RGBA { return Fit(img, 100) }, want: image.Pt(100, 1)},
+		{name: "thumbnail crops the center", w: 300, h: 200, fn: func(img *image.RGBA) *image.RGBA { return Thumbnail(img, 100) }, want: image.Pt(100, 100)},
+		{name: "thumbnail does not upscale", w: 50, h: 80, fn: func(img *image.RGBA) *image.RGBA { return Thumbnail(img, 100) }, want: image.Pt(50, 50)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(filled(tt.w, tt.h, color.RGBA{B: 255, A: 255}))
+			if size := got.Bounds().Size(); size != tt.want {
+				t.Errorf("size = %v, want %v", size, tt.want)
+			}
+		})
+	}
+}
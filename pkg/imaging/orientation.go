@@ -0,0 +1,115 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"image"
+)
+
+const exifOrientationTag = 0x0112
+
+// exifOrientation JPEG의 EXIF(APP1) IFD0에서 방향 값(1~8)을 읽음 (없거나 읽을 수 없으면 1)
+func exifOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return 1
+		}
+		marker := data[pos+1]
+		if marker == 0xDA || marker == 0xD9 { // SOS 이후에는 메타데이터가 없음
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return 1
+		}
+		segment := data[pos+4 : pos+2+length]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiffOrientation(segment[6:])
+		}
+		pos += 2 + length
+	}
+	return 1
+}
+
+// tiffOrientation TIFF 헤더와 IFD0 항목에서 방향 태그 검색
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) != exifOrientationTag {
+			continue
+		}
+		// SHORT 값은 값 영역의 앞 2바이트에 저장됨
+		value := int(order.Uint16(tiff[entry+8:]))
+		if value < 1 || value > 8 {
+			return 1
+		}
+		return value
+	}
+	return 1
+}
+
+// orient EXIF 방향 값에 맞게 이미지를 회전, 반전해 똑바로 세움
+func orient(img *image.RGBA, orientation int) *image.RGBA {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+
+	// 결과 좌표 (x, y)에 대응하는 원본 좌표
+	var source func(x, y int) (int, int)
+	switch orientation {
+	case 2: // 좌우 반전
+		source = func(x, y int) (int, int) { return w - 1 - x, y }
+	case 3: // 180도 회전
+		source = func(x, y int) (int, int) { return w - 1 - x, h - 1 - y }
+	case 4: // 상하 반전
+		source = func(x, y int) (int, int) { return x, h - 1 - y }
+	case 5: // 왼쪽 위-오른쪽 아래 대각선 기준 반전
+		source = func(x, y int) (int, int) { return y, x }
+	case 6: // 시계 방향 90도 회전
+		source = func(x, y int) (int, int) { return y, h - 1 - x }
+	case 7: // 오른쪽 위-왼쪽 아래 대각선 기준 반전
+		source = func(x, y int) (int, int) { return w - 1 - y, h - 1 - x }
+	case 8: // 반시계 방향 90도 회전
+		source = func(x, y int) (int, int) { return w - 1 - y, x }
+	default:
+		return img
+	}
+
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	out := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			sx, sy := source(x, y)
+			si := img.PixOffset(sx, sy)
+			di := out.PixOffset(x, y)
+			copy(out.Pix[di:di+4], img.Pix[si:si+4])
+		}
+	}
+	return out
+}
@@ -0,0 +1,127 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"image"
+	"reflect"
+	"testing"
+)
+
+// exifSegment 방향 태그 하나만 있는 APP1(EXIF) 세그먼트
+func exifSegment(order binary.ByteOrder, orientation uint16) []byte {
+	tiff := make([]byte, 8+2+12+4)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 42)
+	order.PutUint32(tiff[4:], 8)
+	order.PutUint16(tiff[8:], 1)
+	entry := tiff[10:]
+	order.PutUint16(entry[0:], exifOrientationTag)
+	order.PutUint16(entry[2:], 3) // SHORT
+	order.PutUint32(entry[4:], 1)
+	order.PutUint16(entry[8:], orientation)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	return segment(0xE1, payload)
+}
+
+func segment(marker byte, payload []byte) []byte {
+	seg := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(seg[2:], uint16(len(payload)+2))
+	return append(seg, payload...)
+}
+
+func jpegWith(segments ...[]byte) []byte {
+	data := []byte{0xFF, 0xD8}
+	for _, seg := range segments {
+		data = append(data, seg...)
+	}
+	return append(data, 0xFF, 0xD9)
+}
+
+func TestExifOrientation(t *testing.T) {
+	app0 := segment(0xE0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00"))
+	sos := segment(0xDA, []byte{0x01, 0x01, 0x00})
+	truncated := exifSegment(binary.BigEndian, 6)
+
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{name: "little endian", data: jpegWith(exifSegment(binary.LittleEndian, 6)), want: 6},
+		{name: "big endian", data: jpegWith(exifSegment(binary.BigEndian, 8)), want: 8},
+		{name: "after APP0", data: jpegWith(app0, exifSegment(binary.BigEndian, 3)), want: 3},
+		{name: "no EXIF", data: jpegWith(app0), want: 1},
+		{name: "EXIF after start of scan is ignored", data: jpegWith(sos, exifSegment(binary.BigEndian, 6)), want: 1},
+		{name: "out of range value", data: jpegWith(exifSegment(binary.LittleEndian, 9)), want: 1},
+		{name: "truncated segment", data: append([]byte{0xFF, 0xD8}, truncated[:len(truncated)-4]...), want: 1},
+		{name: "not a JPEG", data: []byte("\x89PNG\r\n\x1a\n"), want: 1},
+		{name: "empty", data: nil, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exifOrientation(tt.data); got != tt.want {
+				t.Errorf("exifOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// labeled 각 픽셀의 R 값에 글자를 담은 이미지 (행 단위)
+func labeled(rows ...string) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, len(rows[0]), len(rows)))
+	for y, row := range rows {
+		for x := 0; x < len(row); x++ {
+			i := img.PixOffset(x, y)
+			img.Pix[i], img.Pix[i+3] = row[x], 0xFF
+		}
+	}
+	return img
+}
+
+func labels(img *image.RGBA) []string {
+	b := img.Bounds()
+	rows := make([]string, b.Dy())
+	for y := range rows {
+		row := make([]byte, b.Dx())
+		for x := range row {
+			row[x] = img.Pix[img.PixOffset(b.Min.X+x, b.Min.Y+y)]
+		}
+		rows[y] = string(row)
+	}
+	return rows
+}
+
+func TestOrient(t *testing.T) {
+	// 원본 (3x2)
+	//   abc
+	//   def
+	tests := []struct {
+		orientation int
+		want        []string
+	}{
+		{1, []string{"abc", "def"}},
+		{2, []string{"cba", "fed"}},
+		{3, []string{"fed", "cba"}},
+		{4, []string{"def", "abc"}},
+		{5, []string{"ad", "be", "cf"}},
+		{6, []string{"da", "eb", "fc"}},
+		{7, []string{"fc", "eb", "da"}},
+		{8, []string{"cf", "be", "ad"}},
+		{0, []string{"abc", "def"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(rune('0'+tt.orientation)), func(t *testing.T) {
+			got := labels(orient(labeled("abc", "def"), tt.orientation))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("orient(%d) = %v, want %v", tt.orientation, got, tt.want)
+			}
+		})
+	}
+}
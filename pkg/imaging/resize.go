@@ -0,0 +1,90 @@
+package imaging
+
+import (
+	"image"
+	"math"
+)
+
+// tap 결과 픽셀 하나에 더해지는 원본 픽셀과 가중치
+type tap struct {
+	index  int
+	weight float64
+}
+
+// Resize 이미지를 w x h 크기로 변환
+// 축소할 때는 축소 비율만큼 넓힌 삼각형(선형) 필터를 사용해 원본 픽셀을 모두 반영 (계단 현상 방지)
+func Resize(img *image.RGBA, w, h int) *image.RGBA {
+	b := img.Bounds()
+	if b.Dx() == w && b.Dy() == h {
+		return img
+	}
+	horizontal := resample(img, w, b.Dy(), weights(b.Dx(), w), false)
+	return resample(horizontal, w, h, weights(b.Dy(), h), true)
+}
+
+// weights 원본 길이 src를 dst로 바꿀 때 결과 위치별 가중치 목록
+func weights(src, dst int) [][]tap {
+	scale := float64(src) / float64(dst)
+	support := math.Max(scale, 1)
+
+	result := make([][]tap, dst)
+	for i := range result {
+		center := (float64(i)+0.5)*scale - 0.5
+		start := int(math.Ceil(center - support))
+		end := int(math.Floor(center + support))
+
+		var taps []tap
+		var total float64
+		for j := start; j <= end; j++ {
+			weight := 1 - math.Abs(float64(j)-center)/support
+			if weight <= 0 {
+				continue
+			}
+			taps = append(taps, tap{index: min(max(j, 0), src-1), weight: weight})
+			total += weight
+		}
+		for k := range taps {
+			taps[k].weight /= total
+		}
+		result[i] = taps
+	}
+	return result
+}
+
+// resample 가로(vertical이면 세로) 방향으로만 가중 평균해 w x h 이미지 생성
+func resample(img *image.RGBA, w, h int, taps [][]tap, vertical bool) *image.RGBA {
+	out := image.NewRGBA(image.Rect(0, 0, w, h))
+	origin := img.PixOffset(img.Bounds().Min.X, img.Bounds().Min.Y)
+	// step은 변환하는 방향으로 한 픽셀 이동할 때의 바이트 수, across는 다른 방향
+	step, across := 4, img.Stride
+	if vertical {
+		step, across = img.Stride, 4
+	}
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			i, line := x, y
+			if vertical {
+				i, line = y, x
+			}
+			var r, g, b, a float64
+			for _, t := range taps[i] {
+				p := origin + line*across + t.index*step
+				r += float64(img.Pix[p]) * t.weight
+				g += float64(img.Pix[p+1]) * t.weight
+				b += float64(img.Pix[p+2]) * t.weight
+				a += float64(img.Pix[p+3]) * t.weight
+			}
+			d := out.PixOffset(x, y)
+			out.Pix[d] = clampByte(r)
+			out.Pix[d+1] = clampByte(g)
+			out.Pix[d+2] = clampByte(b)
+			out.Pix[d+3] = clampByte(a)
+		}
+	}
+	return out
+}
+
+func clampByte(v float64) uint8 {
+	return uint8(math.Min(math.Max(math.Round(v), 0), 255))
+}
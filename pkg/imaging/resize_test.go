@@ -0,0 +1,130 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+)
+
+func filled(w, h int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestWeights(t *testing.T) {
+	tests := []struct {
+		name     string
+		src, dst int
+	}{
+		{name: "downscale by 2", src: 8, dst: 4},
+		{name: "downscale by an uneven ratio", src: 7, dst: 3},
+		{name: "upscale", src: 3, dst: 8},
+		{name: "to a single pixel", src: 5, dst: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taps := weights(tt.src, tt.dst)
+			if len(taps) != tt.dst {
+				t.Fatalf("got %d positions, want %d", len(taps), tt.dst)
+			}
+			for i, position := range taps {
+				total := 0.0
+				for _, tap := range position {
+					if tap.index < 0 || tap.index >= tt.src {
+						t.Errorf("position %d: tap index %d out of range", i, tap.index)
+					}
+					total += tap.weight
+				}
+				if math.Abs(total-1) > 1e-9 {
+					t.Errorf("position %d: weights sum to %v, want 1", i, total)
+				}
+			}
+		})
+	}
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		name         string
+		w, h         int
+		toW, toH     int
+		wantSameBase bool
+	}{
+		{name: "same size", w: 10, h: 10, toW: 10, toH: 10, wantSameBase: true},
+		{name: "downscale", w: 40, h: 30, toW: 8, toH: 6},
+		{name: "upscale", w: 3, h: 2, toW: 9, toH: 6},
+		{name: "to a single pixel", w: 7, h: 5, toW: 1, toH: 1},
+	}
+
+	gray := color.RGBA{R: 120, G: 60, B: 200, A: 255}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := filled(tt.w, tt.h, gray)
+			got := Resize(src, tt.toW, tt.toH)
+			if got.Bounds() != image.Rect(0, 0, tt.toW, tt.toH) {
+				t.Fatalf("bounds = %v, want %dx%d", got.Bounds(), tt.toW, tt.toH)
+			}
+			if (got == src) != tt.wantSameBase {
+				t.Errorf("returned the source image = %v, want %v", got == src, tt.wantSameBase)
+			}
+			// 단색 이미지는 크기를 바꿔도 색이 그대로여야 함
+			for y := 0; y < tt.toH; y++ {
+				for x := 0; x < tt.toW; x++ {
+					if c := got.RGBAAt(x, y); c != gray {
+						t.Fatalf("pixel (%d, %d) = %v, want %v", x, y, c, gray)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestResizeAveragesWhenDownscaling(t *testing.T) {
+	// 1픽셀 간격 체크 무늬를 줄이면 계단 현상 없이 중간 밝기가 되어야 함
+	src := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			if (x+y)%2 == 0 {
+				src.SetRGBA(x, y, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+			} else {
+				src.SetRGBA(x, y, color.RGBA{A: 255})
+			}
+		}
+	}
+
+	got := Resize(src, 4, 4)
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			if c := got.RGBAAt(x, y); c.R < 96 || c.R > 160 {
+				t.Errorf("pixel (%d, %d) = %d, want a mid gray", x, y, c.R)
+			}
+		}
+	}
+}
+
+func TestResizeSubImage(t *testing.T) {
+	// 원점이 아닌 SubImage도 자기 영역의 픽셀만 사용
+	src := filled(20, 20, color.RGBA{A: 255})
+	red := color.RGBA{R: 255, A: 255}
+	for y := 10; y < 20; y++ {
+		for x := 10; x < 20; x++ {
+			src.SetRGBA(x, y, red)
+		}
+	}
+
+	got := Resize(src.SubImage(image.Rect(10, 10, 20, 20)).(*image.RGBA), 5, 5)
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			if c := got.RGBAAt(x, y); c != red {
+				t.Fatalf("pixel (%d, %d) = %v, want %v", x, y, c, red)
+			}
+		}
+	}
+}
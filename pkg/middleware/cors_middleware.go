@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -76,7 +77,7 @@ func (c *CORS) Handler(next http.Handler) http.Handler {
 		if r.Method == http.MethodOptions {
 			w.Header().Set("Access-Control-Allow-Methods", strings.Join(c.allowedMethods, ","))
 			w.Header().Set("Access-Control-Allow-Headers", strings.Join(c.allowedHeaders, ","))
-			w.Header().Set("Access-Control-Max-Age", string(c.maxAge))
+			w.Header().Set("Access-Control-Max-Age", strconv.Itoa(c.maxAge))
 			w.Header().Set("Access-Control-Allow-Credentials", "true")
 
 			// Vary 헤더 설정
@@ -13,6 +13,7 @@ type JWTMiddleware struct {
 
 type ErrorResponse struct {
 	Error string `json:"error"`
+	Code  string `json:"code"`
 }
 
 func NewJWTMiddleware(secret string) *JWTMiddleware {
@@ -31,6 +32,7 @@ func (m *JWTMiddleware) ValidateJWT(next http.Handler) http.Handler {
 			w.WriteHeader(http.StatusUnauthorized)
 			json.NewEncoder(w).Encode(ErrorResponse{
 				Error: "unauthorized: " + err.Error(),
+				Code:  "unauthorized",
 			})
 			return
 		}
@@ -41,6 +43,21 @@ func (m *JWTMiddleware) ValidateJWT(next http.Handler) http.Handler {
 	})
 }
 
+// OptionalJWT 토큰이 있으면 검증해 컨텍스트에 저장하고, 없거나 유효하지 않으면 비로그인으로 통과
+// 공개 엔드포인트에서 호출자에 따라 응답을 달리할 때 사용
+func (m *JWTMiddleware) OptionalJWT(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, err := utils.GetJWTClaims(r, m.jwtSecret)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := utils.SetUserContext(r.Context(), claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 // RequireRole 특정 역할이 필요한 엔드포인트를 위한 미들웨어
 func (m *JWTMiddleware) RequireRole(role string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -51,6 +68,7 @@ func (m *JWTMiddleware) RequireRole(role string) func(http.Handler) http.Handler
 				w.WriteHeader(http.StatusUnauthorized)
 				json.NewEncoder(w).Encode(ErrorResponse{
 					Error: "unauthorized: " + err.Error(),
+					Code:  "unauthorized",
 				})
 				return
 			}
@@ -61,6 +79,7 @@ func (m *JWTMiddleware) RequireRole(role string) func(http.Handler) http.Handler
 				w.WriteHeader(http.StatusForbidden)
 				json.NewEncoder(w).Encode(ErrorResponse{
 					Error: "forbidden: insufficient permissions",
+					Code:  "forbidden",
 				})
 				return
 			}
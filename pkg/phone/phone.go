@@ -0,0 +1,212 @@
+// Package phone 전화번호를 E.164 형식으로 정규화하고 국가별 번호 규칙으로 검사
+package phone
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+const (
+	minE164Digits = 8  // 국가 번호를 포함한 최소 자릿수
+	maxE164Digits = 15 // ITU-T E.164 최대 자릿수
+)
+
+// Number 정규화된 전화번호
+type Number struct {
+	E164   string // +821012345678
+	Region string // ISO 3166-1 alpha-2, 규칙표에 없는 국가 번호면 비어 있음
+	Type   string
+	nsn    string
+	rule   *numberRule
+	region *region
+}
+
+// Parse 전화번호를 정규화 (공백, 하이픈, 괄호, 점은 무시하고 국제 전화 접두어 00은 +로 취급)
+// +로 시작하지 않는 국내 번호는 defaultRegion(주소 국가) 기준으로 해석하며,
+// 실패하면 utils.RuleViolation 반환
+func Parse(raw, defaultRegion string) (Number, error) {
+	digits, international, err := clean(raw)
+	if err != nil {
+		return Number{}, err
+	}
+	if digits == "" {
+		return Number{}, violation(utils.ViolationRequired, nil, "phone number is required")
+	}
+
+	if !international {
+		r, ok := regions[strings.ToUpper(defaultRegion)]
+		if !ok {
+			return Number{}, violation(utils.ViolationInvalidFormat, map[string]string{"region": defaultRegion},
+				"phone number must start with + and the country code")
+		}
+		return r.parseNational(strings.TrimPrefix(digits, r.Trunk), r.Trunk != "" && strings.HasPrefix(digits, r.Trunk))
+	}
+
+	if len(digits) < minE164Digits || len(digits) > maxE164Digits || digits[0] == '0' {
+		return Number{}, violation(utils.ViolationInvalidFormat, nil,
+			"phone number must have %d to %d digits including the country code", minE164Digits, maxE164Digits)
+	}
+	for size := 1; size <= 3; size++ {
+		candidates, ok := regionsByCallingCode[digits[:size]]
+		if !ok {
+			continue
+		}
+		nsn := digits[size:]
+		// 국가 번호 뒤에 국내 접두어를 붙여 쓴 경우 (+82 010...)
+		if trunk := candidates[0].Trunk; trunk == "0" && strings.HasPrefix(nsn, trunk) {
+			nsn = nsn[len(trunk):]
+		}
+		return pickRegion(candidates, defaultRegion).parseNational(nsn, true)
+	}
+
+	// 규칙표에 없는 국가 번호는 E.164 자릿수만 검사
+	return Number{E164: "+" + digits, Type: TypeUnknown}, nil
+}
+
+// pickRegion 같은 국가 번호를 쓰는 국가 중 기본 국가 우선
+func pickRegion(candidates []*region, defaultRegion string) *region {
+	for _, r := range candidates {
+		if r.Code == strings.ToUpper(defaultRegion) {
+			return r
+		}
+	}
+	return candidates[0]
+}
+
+// parseNational NSN을 국가 규칙으로 검사 (trunked는 입력에 국내 접두어나 국가 번호가 있었는지 여부)
+func (r *region) parseNational(nsn string, trunked bool) (Number, error) {
+	params := map[string]string{"region": r.Code}
+	for i := range r.Rules {
+		rule := &r.Rules[i]
+		if !rule.Prefix.MatchString(nsn) {
+			continue
+		}
+		if !containsInt(rule.Lengths, len(nsn)) {
+			lengths := make([]string, len(rule.Lengths))
+			for j, n := range rule.Lengths {
+				lengths[j] = strconv.Itoa(n + len(r.nationalPrefix(rule)))
+			}
+			params["type"] = rule.Type
+			params["lengths"] = strings.Join(lengths, ", ")
+			return Number{}, violation(utils.ViolationInvalidFormat, params,
+				"%s %s numbers must have %s digits", r.Code, strings.ReplaceAll(rule.Type, "_", " "), params["lengths"])
+		}
+		if r.Trunk != "" && !trunked && !rule.NoTrunk {
+			// 국내 접두어 없이 입력한 번호는 다른 국가 번호일 수 있어 거부
+			return Number{}, violation(utils.ViolationInvalidFormat, params,
+				"phone number must start with %s or +%s", r.Trunk, r.CallingCode)
+		}
+		return Number{E164: "+" + r.CallingCode + nsn, Region: r.Code, Type: rule.Type, nsn: nsn, rule: rule, region: r}, nil
+	}
+	return Number{}, violation(utils.ViolationInvalidValue, params, "not a valid %s phone number", r.Code)
+}
+
+// International 국제 표기 (+82 10-1234-5678)
+func (n Number) International() string {
+	if n.region == nil {
+		return n.E164
+	}
+	return "+" + n.region.CallingCode + " " + strings.Join(n.groups(), n.region.Separator)
+}
+
+// National 국내 표기 (010-1234-5678, (415) 555-2671)
+func (n Number) National() string {
+	if n.region == nil {
+		return n.E164
+	}
+	groups := n.groups()
+	if n.region.ParenArea && len(groups) > 1 {
+		return "(" + groups[0] + ") " + strings.Join(groups[1:], n.region.Separator)
+	}
+	return n.region.nationalPrefix(n.rule) + strings.Join(groups, n.region.Separator)
+}
+
+// String 저장, 표시에 쓰는 국제 표기
+func (n Number) String() string {
+	return n.International()
+}
+
+// IsMobile 휴대폰 번호 여부 (번호만으로 구분할 수 없는 지역은 true)
+func (n Number) IsMobile() bool {
+	return n.Type == TypeMobile || n.Type == TypeFixedOrMobile
+}
+
+func (r *region) nationalPrefix(rule *numberRule) string {
+	if rule.NoTrunk {
+		return ""
+	}
+	return r.Trunk
+}
+
+// groups NSN을 규칙의 자릿수 묶음으로 나눔 (0은 앞뒤 묶음을 제외한 나머지)
+func (n Number) groups() []string {
+	sizes := n.rule.Groups
+	if len(sizes) == 0 {
+		return []string{n.nsn}
+	}
+	fixed := 0
+	for _, size := range sizes {
+		fixed += size
+	}
+	var groups []string
+	rest := n.nsn
+	for _, size := range sizes {
+		if size == 0 {
+			size = len(n.nsn) - fixed
+		}
+		if size <= 0 || size > len(rest) {
+			continue
+		}
+		groups = append(groups, rest[:size])
+		rest = rest[size:]
+	}
+	if rest != "" {
+		groups = append(groups, rest)
+	}
+	return groups
+}
+
+// clean 구분 기호를 제거한 숫자와 국제 번호 여부 반환
+func clean(raw string) (string, bool, error) {
+	raw = strings.TrimSpace(raw)
+	international := strings.HasPrefix(raw, "+")
+	if international {
+		raw = raw[1:]
+	}
+
+	var b strings.Builder
+	for _, c := range raw {
+		switch {
+		case c >= '0' && c <= '9':
+			b.WriteRune(c)
+		case c >= '０' && c <= '９': // 전각 숫자
+			b.WriteRune(c - '０' + '0')
+		case strings.ContainsRune(" -.()/", c):
+		default:
+			return "", false, violation(utils.ViolationInvalidCharacter, map[string]string{"character": string(c)},
+				"phone number contains an invalid character %q", c)
+		}
+	}
+
+	digits := b.String()
+	if !international && strings.HasPrefix(digits, "00") {
+		return digits[2:], true, nil
+	}
+	return digits, international, nil
+}
+
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func violation(code string, params map[string]string, format string, args ...interface{}) *utils.RuleViolation {
+	return &utils.RuleViolation{Code: code, Message: fmt.Sprintf(format, args...), Params: params}
+}
@@ -0,0 +1,82 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kihyun1998/prisma-market/prisma-user-service/pkg/utils"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		raw           string
+		region        string
+		wantE164      string
+		wantRegion    string
+		wantType      string
+		wantNational  string
+		wantIntl      string
+		wantViolation string
+	}{
+		// KR
+		{name: "KR national mobile", raw: "010-1234-5678", region: "KR", wantE164: "+821012345678", wantRegion: "KR", wantType: TypeMobile, wantNational: "010-1234-5678", wantIntl: "+82 10-1234-5678"},
+		{name: "KR with plus", raw: "+82 10 1234 5678", region: "", wantE164: "+821012345678", wantRegion: "KR", wantType: TypeMobile, wantNational: "010-1234-5678", wantIntl: "+82 10-1234-5678"},
+		{name: "KR with 00 from another region", raw: "0082-10-1234-5678", region: "US", wantE164: "+821012345678", wantRegion: "KR", wantType: TypeMobile, wantNational: "010-1234-5678", wantIntl: "+82 10-1234-5678"},
+		{name: "KR trunk after country code", raw: "+82 010-1234-5678", region: "KR", wantE164: "+821012345678", wantRegion: "KR", wantType: TypeMobile, wantNational: "010-1234-5678", wantIntl: "+82 10-1234-5678"},
+		{name: "KR full-width digits", raw: "０１０１２３４５６７８", region: "KR", wantE164: "+821012345678", wantRegion: "KR", wantType: TypeMobile, wantNational: "010-1234-5678", wantIntl: "+82 10-1234-5678"},
+		{name: "KR Seoul landline", raw: "02-123-4567", region: "KR", wantE164: "+8221234567", wantRegion: "KR", wantType: TypeFixedLine, wantNational: "02-123-4567", wantIntl: "+82 2-123-4567"},
+		{name: "KR national without trunk", raw: "10-1234-5678", region: "KR", wantViolation: utils.ViolationInvalidFormat},
+		{name: "KR mobile too short", raw: "010-1234-567", region: "KR", wantViolation: utils.ViolationInvalidFormat},
+
+		// US
+		{name: "US national", raw: "(415) 555-2671", region: "US", wantE164: "+14155552671", wantRegion: "US", wantType: TypeFixedOrMobile, wantNational: "(415) 555-2671", wantIntl: "+1 415-555-2671"},
+		{name: "US national with trunk", raw: "1-415-555-2671", region: "US", wantE164: "+14155552671", wantRegion: "US", wantType: TypeFixedOrMobile, wantNational: "(415) 555-2671", wantIntl: "+1 415-555-2671"},
+		{name: "US with plus from KR", raw: "+1 415 555 2671", region: "KR", wantE164: "+14155552671", wantRegion: "US", wantType: TypeFixedOrMobile, wantNational: "(415) 555-2671", wantIntl: "+1 415-555-2671"},
+		{name: "NANP prefers the default region", raw: "+1 415 555 2671", region: "CA", wantE164: "+14155552671", wantRegion: "CA", wantType: TypeFixedOrMobile, wantNational: "(415) 555-2671", wantIntl: "+1 415-555-2671"},
+		{name: "US with 00", raw: "001 415 555 2671", region: "JP", wantE164: "+14155552671", wantRegion: "US", wantType: TypeFixedOrMobile, wantNational: "(415) 555-2671", wantIntl: "+1 415-555-2671"},
+		{name: "US exchange starting with 1", raw: "(415) 155-2671", region: "US", wantViolation: utils.ViolationInvalidValue},
+
+		// JP
+		{name: "JP national mobile", raw: "090-1234-5678", region: "JP", wantE164: "+819012345678", wantRegion: "JP", wantType: TypeMobile, wantNational: "090-1234-5678", wantIntl: "+81 90-1234-5678"},
+		{name: "JP with plus", raw: "+81 90-1234-5678", region: "KR", wantE164: "+819012345678", wantRegion: "JP", wantType: TypeMobile, wantNational: "090-1234-5678", wantIntl: "+81 90-1234-5678"},
+		{name: "JP with 00", raw: "00819012345678", region: "", wantE164: "+819012345678", wantRegion: "JP", wantType: TypeMobile, wantNational: "090-1234-5678", wantIntl: "+81 90-1234-5678"},
+		{name: "JP Tokyo landline", raw: "03-1234-5678", region: "JP", wantE164: "+81312345678", wantRegion: "JP", wantType: TypeFixedLine, wantNational: "03-1234-5678", wantIntl: "+81 3-1234-5678"},
+		{name: "JP mobile number read as KR", raw: "090-1234-5678", region: "KR", wantViolation: utils.ViolationInvalidValue},
+
+		// 공통
+		{name: "empty", raw: "  ", region: "KR", wantViolation: utils.ViolationRequired},
+		{name: "letters", raw: "010-CALL-NOW", region: "KR", wantViolation: utils.ViolationInvalidCharacter},
+		{name: "national without region", raw: "010-1234-5678", region: "", wantViolation: utils.ViolationInvalidFormat},
+		{name: "international too short", raw: "+82 1234", region: "", wantViolation: utils.ViolationInvalidFormat},
+		{name: "unknown calling code", raw: "+999 1234 5678", region: "", wantE164: "+99912345678", wantType: TypeUnknown, wantNational: "+99912345678", wantIntl: "+99912345678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.raw, tt.region)
+			if tt.wantViolation != "" {
+				var violation *utils.RuleViolation
+				if !errors.As(err, &violation) {
+					t.Fatalf("expected violation %s, got %v (%+v)", tt.wantViolation, err, got)
+				}
+				if violation.Code != tt.wantViolation {
+					t.Errorf("code = %s, want %s", violation.Code, tt.wantViolation)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.E164 != tt.wantE164 || got.Region != tt.wantRegion || got.Type != tt.wantType {
+				t.Errorf("got (%s, %s, %s), want (%s, %s, %s)", got.E164, got.Region, got.Type, tt.wantE164, tt.wantRegion, tt.wantType)
+			}
+			if national := got.National(); national != tt.wantNational {
+				t.Errorf("National() = %q, want %q", national, tt.wantNational)
+			}
+			if intl := got.International(); intl != tt.wantIntl {
+				t.Errorf("International() = %q, want %q", intl, tt.wantIntl)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package phone
+
+import "regexp"
+
+// 번호 종류
+const (
+	TypeMobile        = "mobile"
+	TypeFixedLine     = "fixed_line"
+	TypeFixedOrMobile = "fixed_line_or_mobile" // 번호만으로 구분할 수 없는 지역 (NANP)
+	TypeVoIP          = "voip"
+	TypeUnknown       = "unknown" // 규칙표에 없는 국가 번호
+)
+
+// numberRule 국내 유효 번호(NSN) 규칙
+type numberRule struct {
+	Type    string
+	Prefix  *regexp.Regexp // NSN 앞자리 (^로 시작)
+	Lengths []int          // 허용하는 NSN 자릿수
+	Groups  []int          // 표시용 자릿수 묶음 (0은 나머지 전부), 비어 있으면 묶지 않음
+	NoTrunk bool           // 국내 표기에 국내 접두어(trunk prefix)를 붙이지 않음
+}
+
+// region 국가별 전화번호 규칙
+type region struct {
+	Code        string // ISO 3166-1 alpha-2
+	CallingCode string
+	Trunk       string // 국내 전화에서 NSN 앞에 붙이는 접두어 (예: KR의 0)
+	Separator   string // 묶음 구분자
+	ParenArea   bool   // 국내 표기에서 첫 묶음을 괄호로 감쌈 (예: (415) 555-2671)
+	Rules       []numberRule
+}
+
+func prefix(pattern string) *regexp.Regexp {
+	return regexp.MustCompile("^(?:" + pattern + ")")
+}
+
+// regions 국가별 번호 길이와 휴대폰 번호 대역
+var regions = map[string]*region{
+	"KR": {
+		Code: "KR", CallingCode: "82", Trunk: "0", Separator: "-",
+		Rules: []numberRule{
+			{Type: TypeMobile, Prefix: prefix(`10`), Lengths: []int{10}, Groups: []int{2, 4, 4}},
+			{Type: TypeMobile, Prefix: prefix(`1[16-9]`), Lengths: []int{9, 10}, Groups: []int{2, 0, 4}},
+			{Type: TypeFixedLine, Prefix: prefix(`2`), Lengths: []int{8, 9}, Groups: []int{1, 0, 4}},
+			{Type: TypeFixedLine, Prefix: prefix(`[3-6][1-5]`), Lengths: []int{9, 10}, Groups: []int{2, 0, 4}},
+			{Type: TypeVoIP, Prefix: prefix(`70`), Lengths: []int{10}, Groups: []int{2, 4, 4}},
+		},
+	},
+	"US": nanp("US"),
+	"CA": nanp("CA"),
+	"JP": {
+		Code: "JP", CallingCode: "81", Trunk: "0", Separator: "-",
+		Rules: []numberRule{
+			{Type: TypeMobile, Prefix: prefix(`[789]0`), Lengths: []int{10}, Groups: []int{2, 4, 4}},
+			{Type: TypeVoIP, Prefix: prefix(`50`), Lengths: []int{10}, Groups: []int{2, 4, 4}},
+			{Type: TypeFixedLine, Prefix: prefix(`[36]`), Lengths: []int{9}, Groups: []int{1, 4, 4}},
+			{Type: TypeFixedLine, Prefix: prefix(`[1-9]`), Lengths: []int{9}, Groups: []int{2, 3, 4}},
+		},
+	},
+	"CN": {
+		Code: "CN", CallingCode: "86", Trunk: "0", Separator: " ",
+		Rules: []numberRule{
+			{Type: TypeMobile, Prefix: prefix(`1[3-9]`), Lengths: []int{11}, Groups: []int{3, 4, 4}, NoTrunk: true},
+			{Type: TypeFixedLine, Prefix: prefix(`[2-9]`), Lengths: []int{9, 10, 11}},
+		},
+	},
+	"GB": {
+		Code: "GB", CallingCode: "44", Trunk: "0", Separator: " ",
+		Rules: []numberRule{
+			{Type: TypeMobile, Prefix: prefix(`7[1-57-9]`), Lengths: []int{10}, Groups: []int{4, 6}},
+			{Type: TypeFixedLine, Prefix: prefix(`2`), Lengths: []int{10}, Groups: []int{2, 4, 4}},
+			{Type: TypeFixedLine, Prefix: prefix(`1`), Lengths: []int{9, 10}, Groups: []int{4, 0}},
+			{Type: TypeFixedLine, Prefix: prefix(`3`), Lengths: []int{10}, Groups: []int{3, 3, 4}},
+		},
+	},
+	"DE": {
+		Code: "DE", CallingCode: "49", Trunk: "0", Separator: " ",
+		Rules: []numberRule{
+			{Type: TypeMobile, Prefix: prefix(`1[5-7]`), Lengths: []int{10, 11}, Groups: []int{3, 0}},
+			{Type: TypeFixedLine, Prefix: prefix(`[2-9]`), Lengths: []int{6, 7, 8, 9, 10, 11}},
+		},
+	},
+	"FR": {
+		Code: "FR", CallingCode: "33", Trunk: "0", Separator: " ",
+		Rules: []numberRule{
+			{Type: TypeMobile, Prefix: prefix(`[67]`), Lengths: []int{9}, Groups: []int{1, 2, 2, 2, 2}},
+			{Type: TypeFixedLine, Prefix: prefix(`[1-5]`), Lengths: []int{9}, Groups: []int{1, 2, 2, 2, 2}},
+			{Type: TypeVoIP, Prefix: prefix(`9`), Lengths: []int{9}, Groups: []int{1, 2, 2, 2, 2}},
+		},
+	},
+	"AU": {
+		Code: "AU", CallingCode: "61", Trunk: "0", Separator: " ",
+		Rules: []numberRule{
+			{Type: TypeMobile, Prefix: prefix(`4`), Lengths: []int{9}, Groups: []int{3, 3, 3}},
+			{Type: TypeFixedLine, Prefix: prefix(`[2378]`), Lengths: []int{9}, Groups: []int{1, 4, 4}},
+		},
+	},
+	"SG": {
+		Code: "SG", CallingCode: "65", Separator: " ",
+		Rules: []numberRule{
+			{Type: TypeMobile, Prefix: prefix(`[89]`), Lengths: []int{8}, Groups: []int{4, 4}},
+			{Type: TypeFixedLine, Prefix: prefix(`6`), Lengths: []int{8}, Groups: []int{4, 4}},
+		},
+	},
+}
+
+// nanp 북미 번호 계획(NANP) 국가 규칙 (지역 번호와 국번 첫 자리는 2-9)
+func nanp(code string) *region {
+	return &region{
+		Code: code, CallingCode: "1", Trunk: "1", Separator: "-", ParenArea: true,
+		Rules: []numberRule{
+			{Type: TypeFixedOrMobile, Prefix: prefix(`[2-9]\d{2}[2-9]`), Lengths: []int{10}, Groups: []int{3, 3, 4}, NoTrunk: true},
+		},
+	}
+}
+
+// regionsByCallingCode 국가 번호로 규칙 찾기 (같은 번호를 쓰는 국가는 번호 규칙으로 구분)
+var regionsByCallingCode = func() map[string][]*region {
+	index := map[string][]*region{}
+	for _, code := range []string{"KR", "US", "CA", "JP", "CN", "GB", "DE", "FR", "AU", "SG"} {
+		r := regions[code]
+		index[r.CallingCode] = append(index[r.CallingCode], r)
+	}
+	return index
+}()
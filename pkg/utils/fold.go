@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// FoldSearchKey 대소문자와 발음 구별 기호를 무시하고 비교하기 위한 검색 키
+// NFD로 분해해 결합 문자를 제거하고 소문자로 바꾼 뒤 연속 공백을 하나로 합침
+// 한글 음절도 자모로 분해되므로 입력 중인 글자("기")가 완성된 글자("김")의 접두어로 일치
+func FoldSearchKey(s string) string {
+	var b strings.Builder
+	for _, c := range norm.NFD.String(s) {
+		if unicode.Is(unicode.Mn, c) {
+			continue
+		}
+		b.WriteRune(unicode.ToLower(c))
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
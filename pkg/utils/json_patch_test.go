@@ -0,0 +1,241 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func decodeJSON(t *testing.T, raw string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		t.Fatalf("invalid test JSON %s: %v", raw, err)
+	}
+	return v
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		ops     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "add object member",
+			doc:  `{"a":1}`,
+			ops:  `[{"op":"add","path":"/b","value":2}]`,
+			want: `{"a":1,"b":2}`,
+		},
+		{
+			name: "add inserts into array",
+			doc:  `{"list":[1,3]}`,
+			ops:  `[{"op":"add","path":"/list/1","value":2}]`,
+			want: `{"list":[1,2,3]}`,
+		},
+		{
+			name: "dash appends to array",
+			doc:  `{"list":[1,2]}`,
+			ops:  `[{"op":"add","path":"/list/-","value":3}]`,
+			want: `{"list":[1,2,3]}`,
+		},
+		{
+			name:    "array index past the end",
+			doc:     `{"list":[1,2]}`,
+			ops:     `[{"op":"add","path":"/list/3","value":3}]`,
+			wantErr: true,
+		},
+		{
+			name:    "array index with leading zero",
+			doc:     `{"list":[1,2]}`,
+			ops:     `[{"op":"replace","path":"/list/01","value":3}]`,
+			wantErr: true,
+		},
+		{
+			name: "tilde escapes in keys",
+			doc:  `{"a/b":1,"c~d":2}`,
+			ops:  `[{"op":"replace","path":"/a~1b","value":10},{"op":"remove","path":"/c~0d"}]`,
+			want: `{"a/b":10}`,
+		},
+		{
+			name: "escape order decodes ~01 as literal ~1",
+			doc:  `{"~1":1}`,
+			ops:  `[{"op":"replace","path":"/~01","value":2}]`,
+			want: `{"~1":2}`,
+		},
+		{
+			name: "remove array element",
+			doc:  `{"list":[1,2,3]}`,
+			ops:  `[{"op":"remove","path":"/list/0"}]`,
+			want: `{"list":[2,3]}`,
+		},
+		{
+			name:    "remove missing member",
+			doc:     `{"a":1}`,
+			ops:     `[{"op":"remove","path":"/b"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "replace missing member",
+			doc:     `{"a":1}`,
+			ops:     `[{"op":"replace","path":"/b","value":2}]`,
+			wantErr: true,
+		},
+		{
+			name: "replace whole document",
+			doc:  `{"a":1}`,
+			ops:  `[{"op":"replace","path":"","value":[1]}]`,
+			want: `[1]`,
+		},
+		{
+			name: "move between members",
+			doc:  `{"a":{"b":1},"c":{}}`,
+			ops:  `[{"op":"move","from":"/a/b","path":"/c/d"}]`,
+			want: `{"a":{},"c":{"d":1}}`,
+		},
+		{
+			name:    "move into itself",
+			doc:     `{"a":{"b":1}}`,
+			ops:     `[{"op":"move","from":"/a","path":"/a/b/c"}]`,
+			wantErr: true,
+		},
+		{
+			name: "move onto itself is a no-op",
+			doc:  `{"a":{"b":1}}`,
+			ops:  `[{"op":"move","from":"/a","path":"/a"}]`,
+			want: `{"a":{"b":1}}`,
+		},
+		{
+			name: "copy is independent of the source",
+			doc:  `{"a":{"b":1}}`,
+			ops:  `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`,
+			want: `{"a":{"b":1},"c":{"b":2}}`,
+		},
+		{
+			name: "passing test",
+			doc:  `{"a":{"b":[1,"x"]}}`,
+			ops:  `[{"op":"test","path":"/a","value":{"b":[1,"x"]}}]`,
+			want: `{"a":{"b":[1,"x"]}}`,
+		},
+		{
+			name:    "failing test aborts the whole patch",
+			doc:     `{"a":1}`,
+			ops:     `[{"op":"add","path":"/b","value":2},{"op":"test","path":"/a","value":2}]`,
+			wantErr: true,
+		},
+		{
+			name:    "missing value",
+			doc:     `{"a":1}`,
+			ops:     `[{"op":"add","path":"/b"}]`,
+			wantErr: true,
+		},
+		{
+			name: "null is a valid value",
+			doc:  `{"a":1}`,
+			ops:  `[{"op":"replace","path":"/a","value":null}]`,
+			want: `{"a":null}`,
+		},
+		{
+			name:    "pointer without leading slash",
+			doc:     `{"a":1}`,
+			ops:     `[{"op":"remove","path":"a"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown operation",
+			doc:     `{"a":1}`,
+			ops:     `[{"op":"increment","path":"/a","value":1}]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := decodeJSON(t, tt.doc)
+			var ops []PatchOperation
+			if err := json.Unmarshal([]byte(tt.ops), &ops); err != nil {
+				t.Fatalf("invalid ops: %v", err)
+			}
+
+			got, err := ApplyJSONPatch(doc, ops)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if want := decodeJSON(t, tt.want); !reflect.DeepEqual(got, want) {
+					t.Errorf("got %v, want %v", got, want)
+				}
+			}
+
+			// 성공, 실패와 관계없이 원본 문서는 바뀌지 않아야 함
+			if original := decodeJSON(t, tt.doc); !reflect.DeepEqual(doc, original) {
+				t.Errorf("original document modified: %v", doc)
+			}
+		})
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+	}{
+		{
+			name:  "set member",
+			doc:   `{"a":1}`,
+			patch: `{"b":2}`,
+			want:  `{"a":1,"b":2}`,
+		},
+		{
+			name:  "null removes member",
+			doc:   `{"a":1,"b":2}`,
+			patch: `{"a":null}`,
+			want:  `{"b":2}`,
+		},
+		{
+			name:  "nested objects merge",
+			doc:   `{"a":{"b":1,"c":2}}`,
+			patch: `{"a":{"c":null,"d":3}}`,
+			want:  `{"a":{"b":1,"d":3}}`,
+		},
+		{
+			name:  "arrays are replaced",
+			doc:   `{"a":[1,2]}`,
+			patch: `{"a":[3]}`,
+			want:  `{"a":[3]}`,
+		},
+		{
+			name:  "object patch replaces scalar",
+			doc:   `{"a":"x"}`,
+			patch: `{"a":{"b":1}}`,
+			want:  `{"a":{"b":1}}`,
+		},
+		{
+			name:  "non-object patch replaces document",
+			doc:   `{"a":1}`,
+			patch: `"x"`,
+			want:  `"x"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := decodeJSON(t, tt.doc)
+			got := ApplyMergePatch(doc, decodeJSON(t, tt.patch))
+			if want := decodeJSON(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+			if original := decodeJSON(t, tt.doc); !reflect.DeepEqual(doc, original) {
+				t.Errorf("original document modified: %v", doc)
+			}
+		})
+	}
+}
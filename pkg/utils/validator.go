@@ -2,64 +2,284 @@ package utils
 
 import (
 	"fmt"
-	"net/mail"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
 	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 규칙 위반 코드
+const (
+	ViolationRequired         = "required"
+	ViolationTooShort         = "too_short"
+	ViolationTooLong          = "too_long"
+	ViolationInvalidCharacter = "invalid_character"
+	ViolationScriptNotAllowed = "script_not_allowed"
+	ViolationMixedScripts     = "mixed_scripts"
+	ViolationConfusable       = "confusable"
+	ViolationInvalidFormat    = "invalid_format"
+	ViolationInvalidValue     = "invalid_value"
+	ViolationInvalidType      = "invalid_type"
+	ViolationUnknownField     = "unknown_field"
+	ViolationNotRemovable     = "not_removable"
+	ViolationTooLarge         = "too_large"
+	ViolationNotAllowed       = "not_allowed"
 )
 
-// ValidateEmail 이메일 주소 유효성 검사
-func ValidateEmail(email string) error {
-	if email == "" {
-		return fmt.Errorf("email is required")
+// RuleViolation 검증 규칙 위반 (Code는 클라이언트 분기용, Params는 메시지 번역용 값)
+type RuleViolation struct {
+	Code    string
+	Message string
+	Params  map[string]string
+}
+
+func (v *RuleViolation) Error() string {
+	return v.Message
+}
+
+// TextRule 문자열 필드 검증 규칙
+// 값은 NFC로 정규화하고 앞뒤 공백을 제거한 뒤 검사하며, 길이는 rune 단위
+type TextRule struct {
+	Label         string         // 에러 메시지에 쓰는 필드 이름
+	MinLength     int            // 0이면 빈 값 허용
+	MaxLength     int            // 0이면 제한 없음
+	Scripts       []string       // 허용 문자 체계 (unicode.Scripts 이름), 비어 있으면 글자를 허용하지 않음
+	AllowDigits   bool           // ASCII 숫자 허용
+	AllowSpaces   bool           // 단어 사이 공백 허용 (연속 공백은 하나로 합침)
+	Symbols       string         // 추가로 허용하는 기호
+	SingleScript  bool           // 여러 문자 체계 혼용 금지 (scriptGroups 조합은 허용)
+	NoConfusables bool           // 라틴 문자와 혼동되는 글자(homoglyph) 금지
+	Pattern       *regexp.Regexp // 정규화된 값이 추가로 만족해야 하는 형식
+}
+
+// ValidationRules 프로필 필드별 검증 규칙
+type ValidationRules struct {
+	Username TextRule
+	Name     TextRule
+	Phone    TextRule
+}
+
+// DefaultValidationRules 기본 검증 규칙
+// 이름은 한글, 한자, 가나, 라틴(악센트 포함) 문자와 공백, 하이픈, 아포스트로피를 허용
+func DefaultValidationRules() *ValidationRules {
+	return &ValidationRules{
+		Username: TextRule{
+			Label:         "username",
+			MinLength:     3,
+			MaxLength:     30,
+			Scripts:       []string{"Latin"},
+			AllowDigits:   true,
+			Symbols:       "_-",
+			SingleScript:  true,
+			NoConfusables: true,
+		},
+		Name: TextRule{
+			Label:        "name",
+			MinLength:    1,
+			MaxLength:    50,
+			Scripts:      []string{"Latin", "Hangul", "Han", "Hiragana", "Katakana"},
+			AllowSpaces:  true,
+			Symbols:      "-'’.·",
+			SingleScript: true,
+		},
+		// 국가별 자릿수와 E.164 정규화는 pkg/phone에서 검사
+		Phone: TextRule{
+			Label:       "phone number",
+			MinLength:   1,
+			MaxLength:   25,
+			AllowDigits: true,
+			AllowSpaces: true,
+			Symbols:     "+-.()/",
+		},
 	}
-	if _, err := mail.ParseAddress(email); err != nil {
-		return fmt.Errorf("invalid email format")
+}
+
+// Check 규칙 설정 오류 확인 (알 수 없는 문자 체계 이름 등)
+func (r *ValidationRules) Check() error {
+	for _, rule := range []TextRule{r.Username, r.Name, r.Phone} {
+		for _, script := range rule.Scripts {
+			if _, ok := unicode.Scripts[script]; !ok {
+				return fmt.Errorf("%s rule: unknown script %q", rule.Label, script)
+			}
+		}
+		if rule.MaxLength > 0 && rule.MinLength > rule.MaxLength {
+			return fmt.Errorf("%s rule: min length %d exceeds max length %d", rule.Label, rule.MinLength, rule.MaxLength)
+		}
 	}
 	return nil
 }
 
-// ValidatePassword 비밀번호 유효성 검사
-func ValidatePassword(password string) error {
-	if len(password) < 8 {
-		return fmt.Errorf("password must be at least 8 characters")
+// scriptGroups 한 이름 안에서 함께 쓰여도 자연스러운 문자 체계 조합
+var scriptGroups = [][]string{
+	{"Hangul", "Han"},
+	{"Han", "Hiragana", "Katakana"},
+}
+
+// Apply 값을 정규화한 뒤 규칙을 검사하고 정규화된 값을 반환
+func (r TextRule) Apply(value string) (string, error) {
+	value = strings.TrimSpace(norm.NFC.String(value))
+	if r.AllowSpaces {
+		value = strings.Join(strings.Fields(value), " ")
 	}
 
-	hasNumber := regexp.MustCompile(`[0-9]`).MatchString(password)
-	hasLetter := regexp.MustCompile(`[A-Za-z]`).MatchString(password)
+	length := utf8.RuneCountInString(value)
+	switch {
+	case length == 0 && r.MinLength > 0:
+		return "", r.violation(ViolationRequired, nil, "%s is required", r.Label)
+	case length < r.MinLength:
+		return "", r.violation(ViolationTooShort, map[string]string{"min": strconv.Itoa(r.MinLength)},
+			"%s must be at least %d characters", r.Label, r.MinLength)
+	case r.MaxLength > 0 && length > r.MaxLength:
+		return "", r.violation(ViolationTooLong, map[string]string{"max": strconv.Itoa(r.MaxLength)},
+			"%s must be at most %d characters", r.Label, r.MaxLength)
+	}
 
-	if !hasNumber || !hasLetter {
-		return fmt.Errorf("password must contain both letters and numbers")
+	used := map[string]bool{}
+	var prev rune
+	for _, c := range value {
+		switch {
+		case c >= '0' && c <= '9' && r.AllowDigits:
+		case c == ' ' && r.AllowSpaces:
+		case strings.ContainsRune(r.Symbols, c):
+		case unicode.Is(unicode.Mn, c) && unicode.IsLetter(prev):
+			// NFC로 합쳐지지 않는 결합 문자는 글자 뒤에서만 허용
+		case unicode.IsLetter(c):
+			script := scriptOf(c)
+			if !r.allowsScript(script) {
+				return "", r.violation(ViolationScriptNotAllowed, map[string]string{"script": script},
+					"%s contains characters from an unsupported script (%s)", r.Label, script)
+			}
+			if script != "Common" && script != "Inherited" {
+				used[script] = true
+			}
+		default:
+			return "", r.violation(ViolationInvalidCharacter, map[string]string{"character": string(c)},
+				"%s contains an invalid character %q", r.Label, c)
+		}
+		if c != ' ' {
+			prev = c
+		}
 	}
 
-	return nil
+	if r.SingleScript && !compatibleScripts(used) {
+		scripts := sortedScripts(used)
+		return "", r.violation(ViolationMixedScripts, map[string]string{"scripts": strings.Join(scripts, ", ")},
+			"%s cannot mix %s characters", r.Label, strings.Join(scripts, " and "))
+	}
+	if r.NoConfusables && IsConfusable(value) {
+		return "", r.violation(ViolationConfusable, nil, "%s contains characters that look like other letters", r.Label)
+	}
+	if r.Pattern != nil && !r.Pattern.MatchString(value) {
+		return "", r.violation(ViolationInvalidFormat, nil, "invalid %s format", r.Label)
+	}
+
+	return value, nil
+}
+
+func (r TextRule) allowsScript(script string) bool {
+	for _, allowed := range r.Scripts {
+		if allowed == script {
+			return true
+		}
+	}
+	return false
 }
 
-// ValidateUsername 사용자 이름 유효성 검사
-func ValidateUsername(username string) error {
-	length := utf8.RuneCountInString(username)
-	if length < 3 || length > 30 {
-		return fmt.Errorf("username must be between 3 and 30 characters")
+func (r TextRule) violation(code string, params map[string]string, format string, args ...interface{}) *RuleViolation {
+	return &RuleViolation{Code: code, Message: fmt.Sprintf(format, args...), Params: params}
+}
+
+// scriptOf 글자가 속한 유니코드 문자 체계 이름
+func scriptOf(c rune) string {
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, c) {
+			return name
+		}
 	}
+	return "Unknown"
+}
 
-	matched, err := regexp.MatchString("^[a-zA-Z0-9_-]+$", username)
-	if err != nil {
-		return fmt.Errorf("invalid username format")
+// compatibleScripts 사용된 문자 체계가 하나이거나 scriptGroups 중 하나에 모두 속하는지 확인
+func compatibleScripts(used map[string]bool) bool {
+	if len(used) <= 1 {
+		return true
 	}
-	if !matched {
-		return fmt.Errorf("username can only contain letters, numbers, underscores, and hyphens")
+	for _, group := range scriptGroups {
+		inGroup := 0
+		for _, script := range group {
+			if used[script] {
+				inGroup++
+			}
+		}
+		if inGroup == len(used) {
+			return true
+		}
 	}
+	return false
+}
 
-	return nil
+func sortedScripts(used map[string]bool) []string {
+	scripts := make([]string, 0, len(used))
+	for script := range used {
+		scripts = append(scripts, script)
+	}
+	sort.Strings(scripts)
+	return scripts
+}
+
+// confusables 라틴 소문자와 모양이 같거나 비슷한 글자 (Unicode confusables 일부)
+var confusables = map[rune]rune{
+	// 키릴 문자
+	'а': 'a', 'в': 'b', 'е': 'e', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o', 'р': 'p', 'с': 'c', 'т': 't',
+	'у': 'y', 'х': 'x', 'ѕ': 's', 'і': 'i', 'ј': 'j', 'ԁ': 'd', 'һ': 'h', 'ӏ': 'l', 'ԛ': 'q', 'ԝ': 'w',
+	'А': 'a', 'В': 'b', 'Е': 'e', 'К': 'k', 'М': 'm', 'Н': 'h', 'О': 'o', 'Р': 'p', 'С': 'c', 'Т': 't',
+	'Х': 'x', 'Ѕ': 's', 'І': 'i', 'Ј': 'j',
+	// 그리스 문자
+	'α': 'a', 'ο': 'o', 'ρ': 'p', 'ν': 'v', 'τ': 't', 'υ': 'u', 'ι': 'i', 'κ': 'k',
+	'Α': 'a', 'Β': 'b', 'Ε': 'e', 'Ζ': 'z', 'Η': 'h', 'Ι': 'i', 'Κ': 'k', 'Μ': 'm', 'Ν': 'n', 'Ο': 'o',
+	'Ρ': 'p', 'Τ': 't', 'Υ': 'y', 'Χ': 'x',
+	// 라틴 확장
+	'ı': 'i', 'ȷ': 'j', 'ɑ': 'a', 'ɡ': 'g', 'ʟ': 'l', 'ɩ': 'i',
 }
 
-// ValidatePhoneNumber 전화번호 유효성 검사
-func ValidatePhoneNumber(phone string) error {
-	matched, err := regexp.MatchString(`^\+?[0-9]{10,15}$`, phone)
-	if err != nil {
-		return fmt.Errorf("invalid phone number format")
+// Skeleton 혼동 가능한 글자를 대표 글자로 바꾼 비교용 문자열
+// 전각 ASCII는 반각으로, homoglyph는 라틴 소문자로 바꾸고 전체를 소문자로 변환
+func Skeleton(value string) string {
+	var b strings.Builder
+	for _, c := range norm.NFC.String(value) {
+		if c >= '！' && c <= '～' {
+			c -= '！' - '!'
+		}
+		if mapped, ok := confusables[c]; ok {
+			c = mapped
+		}
+		b.WriteRune(unicode.ToLower(c))
 	}
-	if !matched {
-		return fmt.Errorf("invalid phone number")
+	return b.String()
+}
+
+// usernameLookalikes 소문자로 바꾼 뒤에도 서로 구분하기 어려운 ASCII 글자
+var usernameLookalikes = strings.NewReplacer("1", "l", "i", "l", "0", "o")
+
+// UsernameKey username 중복 확인용 정규화 키
+// 대소문자와 homoglyph를 통일하고 l/I/1, 0/O처럼 모양이 비슷한 글자를 같은 글자로 취급
+func UsernameKey(username string) string {
+	return usernameLookalikes.Replace(Skeleton(strings.ToLower(norm.NFC.String(username))))
+}
+
+// IsConfusable ASCII가 아닌 글자 중 라틴 문자로 보이는 글자가 있는지 확인
+func IsConfusable(value string) bool {
+	for _, c := range norm.NFC.String(value) {
+		if c < utf8.RuneSelf {
+			continue
+		}
+		if skeleton := Skeleton(string(c)); len(skeleton) == 1 && skeleton[0] < utf8.RuneSelf {
+			return true
+		}
 	}
-	return nil
+	return false
 }
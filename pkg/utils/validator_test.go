@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTextRuleApply(t *testing.T) {
+	rules := DefaultValidationRules()
+	if err := rules.Check(); err != nil {
+		t.Fatalf("default rules: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		rule     TextRule
+		value    string
+		want     string
+		wantCode string
+	}{
+		{name: "username", rule: rules.Username, value: "  kihyun_98 ", want: "kihyun_98"},
+		{name: "username too short", rule: rules.Username, value: "ab", wantCode: ViolationTooShort},
+		{name: "username with space", rule: rules.Username, value: "ki hyun", wantCode: ViolationInvalidCharacter},
+		{name: "username in hangul", rule: rules.Username, value: "기현기현", wantCode: ViolationScriptNotAllowed},
+		{name: "username with cyrillic a", rule: rules.Username, value: "kihyunа", wantCode: ViolationScriptNotAllowed},
+		{name: "empty username", rule: rules.Username, value: "   ", wantCode: ViolationRequired},
+		{name: "hangul name", rule: rules.Name, value: "김 기현", want: "김 기현"},
+		{name: "name spaces collapsed", rule: rules.Name, value: "Mary   Jane", want: "Mary Jane"},
+		{name: "hangul and han together", rule: rules.Name, value: "金기현", want: "金기현"},
+		{name: "latin and hangul mixed", rule: rules.Name, value: "Kim기현", wantCode: ViolationMixedScripts},
+		{name: "name with digits", rule: rules.Name, value: "Kim2", wantCode: ViolationInvalidCharacter},
+		{name: "decomposed hangul is normalized", rule: rules.Name, value: "\u1100\u1175\u11a8", want: "\uae31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rule.Apply(tt.value)
+			if tt.wantCode != "" {
+				var violation *RuleViolation
+				if !errors.As(err, &violation) {
+					t.Fatalf("expected violation %s, got %v (%q)", tt.wantCode, err, got)
+				}
+				if violation.Code != tt.wantCode {
+					t.Errorf("code = %s, want %s", violation.Code, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsernameKey(t *testing.T) {
+	tests := []struct {
+		a, b string
+		same bool
+	}{
+		{"kihyun", "Kihyun", true},
+		{"kihyun", "KIHYUN", true},
+		{"kihyun", "k1hyun", true},
+		{"kihyun", "kIhyun", true},
+		{"kihyun", "ｋｉｈｙｕｎ", true},
+		{"kihyun", "kihyunа", false},
+		{"polo", "p0l0", true},
+		{"kihyun", "kihyun_", false},
+		{"kihyun", "kihyon", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			a, b := UsernameKey(tt.a), UsernameKey(tt.b)
+			if (a == b) != tt.same {
+				t.Errorf("UsernameKey(%q) = %q, UsernameKey(%q) = %q, want same = %v", tt.a, a, tt.b, b, tt.same)
+			}
+		})
+	}
+}